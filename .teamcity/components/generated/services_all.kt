@@ -65,6 +65,7 @@ val services = mapOf(
     "dms" to ServiceSpec("DMS (Database Migration)", vpcLock = true),
     "docdb" to ServiceSpec("DocumentDB", vpcLock = true),
     "docdbelastic" to ServiceSpec("DocumentDB Elastic"),
+    "drs" to ServiceSpec("DRS (Elastic Disaster Recovery)"),
     "ds" to ServiceSpec("Directory Service", vpcLock = true),
     "dynamodb" to ServiceSpec("DynamoDB"),
     "ec2" to ServiceSpec("EC2 (Elastic Compute Cloud)", vpcLock = true),
@@ -95,6 +96,7 @@ val services = mapOf(
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
     "guardduty" to ServiceSpec("GuardDuty"),
+    "health" to ServiceSpec("Health"),
     "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
     "identitystore" to ServiceSpec("SSO Identity Store"),
@@ -127,6 +129,7 @@ val services = mapOf(
     "mediapackage" to ServiceSpec("Elemental MediaPackage"),
     "mediastore" to ServiceSpec("Elemental MediaStore"),
     "memorydb" to ServiceSpec("MemoryDB for Redis"),
+    "mgn" to ServiceSpec("Application Migration (Mgn)"),
     "mq" to ServiceSpec("MQ", vpcLock = true),
     "mwaa" to ServiceSpec("MWAA (Managed Workflows for Apache Airflow)", vpcLock = true),
     "neptune" to ServiceSpec("Neptune"),
@@ -137,6 +140,7 @@ val services = mapOf(
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
+    "osis" to ServiceSpec("OpenSearch Ingestion"),
     "outposts" to ServiceSpec("Outposts"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
@@ -149,12 +153,15 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "rekognition" to ServiceSpec("Rekognition"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
+    "robomaker" to ServiceSpec("RoboMaker"),
     "rolesanywhere" to ServiceSpec("Roles Anywhere"),
     "route53" to ServiceSpec("Route 53", vpcLock = true),
     "route53domains" to ServiceSpec("Route 53 Domains"),
+    "route53recoverycluster" to ServiceSpec("Route 53 Recovery Cluster"),
     "route53recoverycontrolconfig" to ServiceSpec("Route 53 Recovery Control Config"),
     "route53recoveryreadiness" to ServiceSpec("Route 53 Recovery Readiness"),
     "route53resolver" to ServiceSpec("Route 53 Resolver", vpcLock = true),
@@ -178,6 +185,8 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "simspaceweaver" to ServiceSpec("SimSpace Weaver"),
+    "snowball" to ServiceSpec("Snow Family"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
@@ -189,6 +198,7 @@ val services = mapOf(
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
+    "tnb" to ServiceSpec("Telco Network Builder"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
     "vpclattice" to ServiceSpec("VPC Lattice"),
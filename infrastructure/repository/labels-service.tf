@@ -222,6 +222,7 @@ variable "service_labels" {
     "opsworks",
     "opsworkscm",
     "organizations",
+    "osis",
     "outposts",
     "panorama",
     "personalize",
@@ -284,6 +285,7 @@ variable "service_labels" {
     "shield",
     "signer",
     "simpledb",
+    "simspaceweaver",
     "sms",
     "snowball",
     "snowdevicemanagement",
@@ -303,6 +305,7 @@ variable "service_labels" {
     "textract",
     "timestreamquery",
     "timestreamwrite",
+    "tnb",
     "transcribe",
     "transcribestreaming",
     "transfer",
@@ -0,0 +1,139 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// apiCallLogEntry is a single structured log record for an AWS API call.
+type apiCallLogEntry struct {
+	Timestamp  time.Time `json:"@timestamp"`
+	SDK        string    `json:"sdk"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	Region     string    `json:"region,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	StatusCode int       `json:"status_code,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	RetryCount int       `json:"retry_count"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// apiCallLogger writes one JSON object per line for each AWS API call.
+type apiCallLogger struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func newAPICallLogger(w io.Writer) *apiCallLogger {
+	return &apiCallLogger{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// newAPICallFileLogger returns an apiCallLogger that appends to the specified file.
+// The file is held open for the lifetime of the provider process.
+func newAPICallFileLogger(path string) (*apiCallLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API call log file (%s): %w", path, err)
+	}
+
+	return newAPICallLogger(f), nil
+}
+
+func (l *apiCallLogger) log(entry *apiCallLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Logging is best effort and must never fail an API call.
+	_ = l.enc.Encode(entry)
+}
+
+// registerSDKv1Handlers adds a handler to the AWS SDK for Go v1 session that logs every completed API call.
+// Service clients created from the session inherit the handler.
+func (l *apiCallLogger) registerSDKv1Handlers(sess *session.Session) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APICallLog",
+		Fn: func(r *request.Request) {
+			entry := &apiCallLogEntry{
+				Timestamp:  r.Time.UTC(),
+				SDK:        "v1",
+				Service:    r.ClientInfo.ServiceID,
+				Region:     r.ClientInfo.SigningRegion,
+				RequestID:  r.RequestID,
+				DurationMS: time.Since(r.Time).Milliseconds(),
+				RetryCount: r.RetryCount,
+			}
+
+			if r.Operation != nil {
+				entry.Operation = r.Operation.Name
+			}
+
+			if r.HTTPResponse != nil {
+				entry.StatusCode = r.HTTPResponse.StatusCode
+			}
+
+			if r.Error != nil {
+				entry.Error = r.Error.Error()
+			}
+
+			l.log(entry)
+		},
+	})
+}
+
+// registerSDKv2Middleware adds a middleware to the AWS SDK for Go v2 configuration that logs every completed API call.
+// Service clients created from the configuration inherit the middleware.
+func (l *apiCallLogger) registerSDKv2Middleware(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("terraform-provider-aws.APICallLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			entry := &apiCallLogEntry{
+				Timestamp:  start.UTC(),
+				SDK:        "v2",
+				Service:    awsmiddleware.GetServiceID(ctx),
+				Operation:  awsmiddleware.GetOperationName(ctx),
+				Region:     awsmiddleware.GetRegion(ctx),
+				DurationMS: time.Since(start).Milliseconds(),
+			}
+
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				entry.RequestID = v
+			}
+
+			if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+				entry.StatusCode = v.StatusCode
+			}
+
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				entry.RetryCount = len(v.Results) - 1
+			}
+
+			if err != nil {
+				entry.Error = err.Error()
+			}
+
+			l.log(entry)
+
+			return out, metadata, err
+		}), middleware.After)
+	})
+}
@@ -0,0 +1,63 @@
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAPICallLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := newAPICallLogger(&buf)
+
+	logger.log(&apiCallLogEntry{
+		Timestamp:  time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+		SDK:        "v1",
+		Service:    "EC2",
+		Operation:  "DescribeVpcs",
+		RequestID:  "abc-123",
+		StatusCode: 200,
+		DurationMS: 42,
+		RetryCount: 2,
+	})
+	logger.log(&apiCallLogEntry{
+		SDK:       "v2",
+		Service:   "Lambda",
+		Operation: "GetFunction",
+		Error:     "ResourceNotFoundException",
+	})
+
+	dec := json.NewDecoder(&buf)
+
+	var got map[string]any
+	if err := dec.Decode(&got); err != nil {
+		t.Fatalf("decoding first entry: %s", err)
+	}
+
+	for k, want := range map[string]any{
+		"service":     "EC2",
+		"operation":   "DescribeVpcs",
+		"request_id":  "abc-123",
+		"duration_ms": float64(42),
+		"retry_count": float64(2),
+	} {
+		if got[k] != want {
+			t.Errorf("%s: got %v, expected %v", k, got[k], want)
+		}
+	}
+
+	got = nil
+	if err := dec.Decode(&got); err != nil {
+		t.Fatalf("decoding second entry: %s", err)
+	}
+
+	if _, ok := got["request_id"]; ok {
+		t.Errorf("request_id: expected to be omitted")
+	}
+	if got["error"] != "ResourceNotFoundException" {
+		t.Errorf("error: got %v, expected %v", got["error"], "ResourceNotFoundException")
+	}
+}
@@ -3,6 +3,7 @@ package conns
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -96,3 +97,13 @@ func (client *AWSClient) DefaultKMSKeyPolicy() string {
 func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
+
+// IAMPropagationTimeout returns how long to retry operations that fail until newly created or updated IAM resources have propagated.
+// The provider's iam_propagation_timeout argument, if set, overrides the specified service default.
+func (client *AWSClient) IAMPropagationTimeout(defaultTimeout time.Duration) time.Duration {
+	if client.iamPropagationTimeout > 0 {
+		return client.iamPropagationTimeout
+	}
+
+	return defaultTimeout
+}
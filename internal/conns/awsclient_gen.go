@@ -3,6 +3,7 @@ package conns
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go-v2/service/account"
@@ -245,6 +246,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/osis"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/panorama"
 	"github.com/aws/aws-sdk-go/service/personalize"
@@ -297,6 +299,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
 	"github.com/aws/aws-sdk-go/service/sms"
 	"github.com/aws/aws-sdk-go/service/snowball"
 	"github.com/aws/aws-sdk-go/service/snowdevicemanagement"
@@ -314,6 +317,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/textract"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/tnb"
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
@@ -344,7 +348,8 @@ type AWSClient struct {
 	Session                 *session.Session
 	TerraformVersion        string
 
-	httpClient *http.Client
+	httpClient            *http.Client
+	iamPropagationTimeout time.Duration
 
 	dsClient        lazyClient[*directoryservice_sdkv2.Client]
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
@@ -568,6 +573,7 @@ type AWSClient struct {
 	oamClient                        *oam.Client
 	opensearchConn                   *opensearchservice.OpenSearchService
 	opensearchserverlessClient       *opensearchserverless.Client
+	osisConn                         *osis.OSIS
 	opsworksConn                     *opsworks.OpsWorks
 	opsworkscmConn                   *opsworkscm.OpsWorksCM
 	organizationsConn                *organizations.Organizations
@@ -644,6 +650,7 @@ type AWSClient struct {
 	shieldConn                       *shield.Shield
 	signerConn                       *signer.Signer
 	sdbConn                          *simpledb.SimpleDB
+	simspaceweaverConn               *simspaceweaver.SimSpaceWeaver
 	snowdevicemanagementConn         *snowdevicemanagement.SnowDeviceManagement
 	snowballConn                     *snowball.Snowball
 	storagegatewayConn               *storagegateway.StorageGateway
@@ -652,6 +659,7 @@ type AWSClient struct {
 	textractConn                     *textract.Textract
 	timestreamqueryConn              *timestreamquery.TimestreamQuery
 	timestreamwriteConn              *timestreamwrite.TimestreamWrite
+	tnbConn                          *tnb.Tnb
 	transcribeClient                 *transcribe.Client
 	transcribestreamingConn          *transcribestreamingservice.TranscribeStreamingService
 	transferConn                     *transfer.Transfer
@@ -1542,6 +1550,10 @@ func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
 	return client.opensearchConn
 }
 
+func (client *AWSClient) OpenSearchIngestionConn() *osis.OSIS {
+	return client.osisConn
+}
+
 func (client *AWSClient) OpenSearchServerlessClient() *opensearchserverless.Client {
 	return client.opensearchserverlessClient
 }
@@ -1862,6 +1874,10 @@ func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
 	return client.sdbConn
 }
 
+func (client *AWSClient) SimSpaceWeaverConn() *simspaceweaver.SimSpaceWeaver {
+	return client.simspaceweaverConn
+}
+
 func (client *AWSClient) SnowDeviceManagementConn() *snowdevicemanagement.SnowDeviceManagement {
 	return client.snowdevicemanagementConn
 }
@@ -1894,6 +1910,10 @@ func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite
 	return client.timestreamwriteConn
 }
 
+func (client *AWSClient) TNBConn() *tnb.Tnb {
+	return client.tnbConn
+}
+
 func (client *AWSClient) TranscribeClient() *transcribe.Client {
 	return client.transcribeClient
 }
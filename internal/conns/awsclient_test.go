@@ -2,6 +2,7 @@ package conns
 
 import (
 	"testing"
+	"time"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -87,3 +88,39 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientIAMPropagationTimeout(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Expected  time.Duration
+	}{
+		{
+			Name:      "not configured",
+			AWSClient: &AWSClient{},
+			Expected:  2 * time.Minute,
+		},
+		{
+			Name: "configured",
+			AWSClient: &AWSClient{
+				iamPropagationTimeout: 10 * time.Minute,
+			},
+			Expected: 10 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.AWSClient.IAMPropagationTimeout(2 * time.Minute)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
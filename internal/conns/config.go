@@ -2,10 +2,15 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"log"
 	"strings"
+	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -22,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/lightsail"
@@ -36,6 +42,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/smithy-go"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -48,6 +55,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallLogFile                 string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -58,6 +66,7 @@ type Config struct {
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
+	IAMPropagationTimeout          time.Duration
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
@@ -148,6 +157,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.APICallLogFile != "" {
+		tflog.Debug(ctx, "Configuring AWS API call logging", map[string]any{
+			"tf_aws.api_call_log_file": c.APICallLogFile,
+		})
+		logger, err := newAPICallFileLogger(c.APICallLogFile)
+		if err != nil {
+			return nil, diag.Errorf("configuring AWS API call logging: %s", err)
+		}
+
+		logger.registerSDKv1Handlers(sess)
+		logger.registerSDKv2Middleware(&cfg)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -179,14 +201,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
-		DNSSuffix = p.DNSSuffix()
+	regionPartition, DNSSuffix := PartitionForRegion(c.Region)
+
+	if partition == "" {
+		// The partition cannot be inferred from the caller identity when account details are not requested,
+		// e.g. when targeting S3-compatible or emulated AWS API implementations, so derive it from the region.
+		tflog.Debug(ctx, "AWS partition not found for provider, using partition for region", map[string]any{
+			"tf_aws.partition": regionPartition,
+		})
+		partition = regionPartition
 	}
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
+	client.iamPropagationTimeout = c.IAMPropagationTimeout
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
@@ -225,6 +254,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	globalAcceleratorConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
 	}
+	healthConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[names.Health]),
+	}
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[names.Route53]),
 	}
@@ -242,6 +274,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	switch partition {
 	case endpoints.AwsPartitionID:
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
+		healthConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
 		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
@@ -258,6 +291,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
+	client.healthConn = health.New(sess.Copy(healthConfig))
 	client.route53Conn = route53.New(sess.Copy(route53Config))
 	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
 	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
@@ -537,6 +571,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// AWS SDK for Go v2 custom API clients.
 
+	// Replaces the generated client so that it has the same service-specific retries as the AWS SDK for Go v1 EC2 client.
+	// AWS SDK for Go v2 retryers only see the error, not the operation, so the error codes and messages are matched across all operations.
+	client.ec2Client.init(&cfg, func() *ec2_sdkv2.Client {
+		return ec2_sdkv2.NewFromConfig(cfg, func(o *ec2_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
+				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+
+			if v, ok := o.Retryer.(aws_sdkv2.RetryerV2); ok {
+				o.Retryer = AddIsErrorRetryables(v, retry.IsErrorRetryableFunc(isEC2ErrorRetryable))
+			}
+		})
+	})
+
 	client.route53domainsClient = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
 		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
 			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
@@ -548,3 +596,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	return client, nil
 }
+
+// isEC2ErrorRetryable is the AWS SDK for Go v2 equivalent of the AWS SDK for Go v1 EC2 client's Handlers.Retry customizations.
+func isEC2ErrorRetryable(err error) aws_sdkv2.Ternary {
+	var apiErr smithy.APIError
+
+	if !errors.As(err, &apiErr) {
+		return aws_sdkv2.UnknownTernary
+	}
+
+	switch code, message := apiErr.ErrorCode(), apiErr.ErrorMessage(); {
+	case code == "InvalidParameterValue" && strings.Contains(message, "This call cannot be completed because there are pending VPNs or Virtual Interfaces"),
+		code == "OperationNotPermitted" && strings.Contains(message, "Endpoint cannot be created while another endpoint is being created"),
+		code == "ConcurrentMutationLimitExceeded" && strings.Contains(message, "Cannot initiate another change for this endpoint at this time"),
+		code == "VpnConnectionLimitExceeded" && strings.Contains(message, "maximum number of mutating objects has been reached"),
+		code == "VpnGatewayLimitExceeded" && strings.Contains(message, "maximum number of mutating objects has been reached"):
+		return aws_sdkv2.TrueTernary
+	case code == "InsufficientInstanceCapacity":
+		// `InsufficientInstanceCapacity` error has status code 500 and AWS SDK try retry this error by default.
+		return aws_sdkv2.FalseTernary
+	}
+
+	return aws_sdkv2.UnknownTernary
+}
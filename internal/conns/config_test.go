@@ -0,0 +1,83 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigureProvider_skipRequestingAccountIDCustomEndpoint(t *testing.T) {
+	// No t.Parallel(): t.Setenv is used to isolate the test from the caller's AWS configuration.
+	for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", "AWS_REGION", "AWS_DEFAULT_REGION"} {
+		t.Setenv(k, "")
+	}
+
+	const endpoint = "http://localhost:9000"
+	c := &Config{
+		AccessKey:                     "minioadmin",
+		EC2MetadataServiceEnableState: imds.ClientDisabled,
+		Endpoints: map[string]string{
+			names.S3: endpoint,
+		},
+		Region:                  "minio",
+		S3UsePathStyle:          true,
+		SecretKey:               "minioadmin",
+		SharedConfigFiles:       []string{"/dev/null"},
+		SharedCredentialsFiles:  []string{"/dev/null"},
+		SkipCredsValidation:     true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	client, diags := c.ConfigureProvider(context.Background(), &AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := client.AccountID, ""; got != want {
+		t.Errorf("AccountID: got %q, expected %q", got, want)
+	}
+	if got, want := client.Partition, "aws"; got != want {
+		t.Errorf("Partition: got %q, expected %q", got, want)
+	}
+	if got, want := client.Region, "minio"; got != want {
+		t.Errorf("Region: got %q, expected %q", got, want)
+	}
+	if got, want := client.DNSSuffix, "amazonaws.com"; got != want {
+		t.Errorf("DNSSuffix: got %q, expected %q", got, want)
+	}
+
+	// ARNs constructed without an account ID must still have a valid partition.
+	bucketARN := arn.ARN{
+		Partition: client.Partition,
+		Service:   "s3",
+		Resource:  "test-bucket",
+	}.String()
+
+	if got, want := bucketARN, "arn:aws:s3:::test-bucket"; got != want {
+		t.Errorf("ARN: got %q, expected %q", got, want)
+	}
+	if !arn.IsARN(bucketARN) {
+		t.Errorf("ARN: %q is not a valid ARN", bucketARN)
+	}
+
+	if got, want := client.s3Conn.Endpoint, endpoint; got != want {
+		t.Errorf("S3 endpoint: got %q, expected %q", got, want)
+	}
+	if got, want := aws.BoolValue(client.s3Conn.Config.S3ForcePathStyle), true; got != want {
+		t.Errorf("S3 path style: got %t, expected %t", got, want)
+	}
+
+	// "Global" services are pinned to the partition's Region.
+	if got, want := aws.StringValue(client.route53Conn.Config.Region), "us-east-1"; got != want {
+		t.Errorf("Route 53 Region: got %q, expected %q", got, want)
+	}
+	if got, want := aws.StringValue(client.healthConn.Config.Region), "us-east-1"; got != want {
+		t.Errorf("Health Region: got %q, expected %q", got, want)
+	}
+}
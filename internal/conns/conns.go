@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -95,3 +96,14 @@ func ReverseDNS(hostname string) string {
 
 	return strings.Join(parts, ".")
 }
+
+// PartitionForRegion returns the partition ID and DNS suffix for the specified region.
+// Regions unknown to the AWS SDK, such as those used by S3-compatible or emulated
+// AWS API implementations, are assumed to be in the standard AWS partition.
+func PartitionForRegion(region string) (string, string) {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return p.ID(), p.DNSSuffix()
+	}
+
+	return endpoints.AwsPartitionID, "amazonaws.com"
+}
@@ -50,3 +50,61 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestPartitionForRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		region            string
+		expectedPartition string
+		expectedDNSSuffix string
+	}{
+		{
+			name:              "us-west-2",
+			region:            "us-west-2",
+			expectedPartition: "aws",
+			expectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			name:              "cn-north-1",
+			region:            "cn-north-1",
+			expectedPartition: "aws-cn",
+			expectedDNSSuffix: "amazonaws.com.cn",
+		},
+		{
+			name:              "us-gov-west-1",
+			region:            "us-gov-west-1",
+			expectedPartition: "aws-us-gov",
+			expectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			name:              "unknown region",
+			region:            "minio",
+			expectedPartition: "aws",
+			expectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			name:              "empty region",
+			region:            "",
+			expectedPartition: "aws",
+			expectedDNSSuffix: "amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			partition, dnsSuffix := PartitionForRegion(testCase.region)
+
+			if got, want := partition, testCase.expectedPartition; got != want {
+				t.Errorf("partition: got %q, expected %q", got, want)
+			}
+			if got, want := dnsSuffix, testCase.expectedDNSSuffix; got != want {
+				t.Errorf("DNS suffix: got %q, expected %q", got, want)
+			}
+		})
+	}
+}
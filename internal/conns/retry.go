@@ -0,0 +1,32 @@
+package conns
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// AddIsErrorRetryables returns a Retryer which runs the specified IsErrorRetryables
+// before deferring to the specified Retryer.
+// The specified Retryer's maximum attempts and backoff, configured from the provider's
+// max_retries argument, are unchanged.
+// This is the AWS SDK for Go v2 equivalent of pushing a handler onto an AWS SDK for Go v1 client's
+// Handlers.Retry list, e.g. to treat service-specific error codes as retryable.
+func AddIsErrorRetryables(r aws.RetryerV2, rs ...retry.IsErrorRetryable) aws.RetryerV2 {
+	return &withIsErrorRetryables{
+		RetryerV2:         r,
+		isErrorRetryables: retry.IsErrorRetryables(rs),
+	}
+}
+
+type withIsErrorRetryables struct {
+	aws.RetryerV2
+	isErrorRetryables retry.IsErrorRetryables
+}
+
+func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
+	if v := r.isErrorRetryables.IsErrorRetryable(err); v != aws.UnknownTernary {
+		return v.Bool()
+	}
+
+	return r.RetryerV2.IsErrorRetryable(err)
+}
@@ -0,0 +1,121 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+func TestAddIsErrorRetryables(t *testing.T) {
+	t.Parallel()
+
+	f := func(err error) aws.Ternary {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.ErrorCode() {
+			case "ConflictException":
+				return aws.TrueTernary
+			case "ThrottlingException":
+				return aws.FalseTernary
+			}
+		}
+
+		return aws.UnknownTernary
+	}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "retryable by custom retryable",
+			err:      &smithy.GenericAPIError{Code: "ConflictException"},
+			expected: true,
+		},
+		{
+			name:     "not retryable by custom retryable",
+			err:      &smithy.GenericAPIError{Code: "ThrottlingException"},
+			expected: false,
+		},
+		{
+			name:     "retryable by standard retryer",
+			err:      &smithy.GenericAPIError{Code: "RequestLimitExceeded"},
+			expected: true,
+		},
+		{
+			name:     "not retryable by standard retryer",
+			err:      &smithy.GenericAPIError{Code: "ValidationException"},
+			expected: false,
+		},
+	}
+
+	retryer := AddIsErrorRetryables(retry.NewStandard(), retry.IsErrorRetryableFunc(f))
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAddIsErrorRetryablesMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	retryer := AddIsErrorRetryables(retry.AddWithMaxAttempts(retry.NewStandard(), 7))
+
+	if got, want := retryer.MaxAttempts(), 7; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+}
+
+func TestIsEC2ErrorRetryable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected aws.Ternary
+	}{
+		{
+			name:     "pending VPNs",
+			err:      &smithy.GenericAPIError{Code: "InvalidParameterValue", Message: "This call cannot be completed because there are pending VPNs or Virtual Interfaces"},
+			expected: aws.TrueTernary,
+		},
+		{
+			name:     "other InvalidParameterValue",
+			err:      &smithy.GenericAPIError{Code: "InvalidParameterValue", Message: "Invalid value"},
+			expected: aws.UnknownTernary,
+		},
+		{
+			name:     "insufficient instance capacity",
+			err:      &smithy.GenericAPIError{Code: "InsufficientInstanceCapacity"},
+			expected: aws.FalseTernary,
+		},
+		{
+			name:     "not an API error",
+			err:      errors.New("test"),
+			expected: aws.UnknownTernary,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isEC2ErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("isEC2ErrorRetryable = %v, want %v", got, want)
+			}
+		})
+	}
+}
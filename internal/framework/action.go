@@ -0,0 +1,71 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// ResourceWithAction is a structure to be embedded within a Resource that performs a one-shot, imperative operation (an "action"),
+// e.g. rebooting a DB instance or rotating a secret immediately.
+// The operation is performed by the embedding Resource's Create method, which runs when the resource is first created
+// and again whenever it is replaced, typically because a value in its `triggers` map changed.
+// There is no remote object backing an action, so Read, Update and Delete only maintain Terraform state.
+type ResourceWithAction struct {
+	ResourceWithConfigure
+}
+
+// Read leaves the action's state unchanged.
+func (r *ResourceWithAction) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+// Update copies planned values (e.g. timeouts) into state. All other attributes of an action require replacement.
+func (r *ResourceWithAction) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.State.Raw = request.Plan.Raw
+}
+
+// Delete removes the action from state. Actions cannot be undone.
+func (r *ResourceWithAction) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+// ActionTriggersAttribute returns the standard `triggers` attribute.
+// Any change to its value causes the action to be performed again.
+func ActionTriggersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// ActionIdempotencyTokenAttribute returns the standard `idempotency_token` attribute.
+// If not configured, a unique token is generated each time the action is performed.
+// A configured token is sent unchanged each time the action is performed, so it must be changed along with `triggers`
+// for the service to treat the repeated request as a new operation.
+func ActionIdempotencyTokenAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// ActionIdempotencyToken returns the configured idempotency token, or a newly generated one if none is configured.
+func ActionIdempotencyToken(v types.String) types.String {
+	if v.IsNull() || v.IsUnknown() {
+		return types.StringValue(id.UniqueId())
+	}
+
+	return v
+}
@@ -0,0 +1,28 @@
+package framework_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+func TestActionIdempotencyToken(t *testing.T) {
+	t.Parallel()
+
+	if got, want := framework.ActionIdempotencyToken(types.StringValue("token")), types.StringValue("token"); !got.Equal(want) {
+		t.Errorf("configured token: got %s, want %s", got, want)
+	}
+
+	for _, v := range []types.String{types.StringNull(), types.StringUnknown()} {
+		got := framework.ActionIdempotencyToken(v)
+
+		if got.IsNull() || got.IsUnknown() || got.ValueString() == "" {
+			t.Errorf("unconfigured token (%s): got %s, want generated value", v, got)
+		}
+	}
+
+	if a, b := framework.ActionIdempotencyToken(types.StringNull()), framework.ActionIdempotencyToken(types.StringNull()); a.Equal(b) {
+		t.Errorf("generated tokens are not unique: %s", a)
+	}
+}
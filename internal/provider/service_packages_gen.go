@@ -70,6 +70,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -100,6 +101,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/health"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
@@ -136,6 +138,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
@@ -146,6 +149,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/osis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
@@ -158,12 +162,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/robomaker"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycluster"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -187,6 +194,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -198,6 +207,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
@@ -276,6 +286,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		dms.ServicePackage,
 		docdb.ServicePackage,
 		docdbelastic.ServicePackage,
+		drs.ServicePackage,
 		ds.ServicePackage,
 		dynamodb.ServicePackage,
 		ec2.ServicePackage,
@@ -306,6 +317,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		grafana.ServicePackage,
 		greengrass.ServicePackage,
 		guardduty.ServicePackage,
+		health.ServicePackage,
 		healthlake.ServicePackage,
 		iam.ServicePackage,
 		identitystore.ServicePackage,
@@ -342,6 +354,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		mediastore.ServicePackage,
 		memorydb.ServicePackage,
 		meta.ServicePackage,
+		mgn.ServicePackage,
 		mq.ServicePackage,
 		mwaa.ServicePackage,
 		neptune.ServicePackage,
@@ -352,6 +365,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		opensearchserverless.ServicePackage,
 		opsworks.ServicePackage,
 		organizations.ServicePackage,
+		osis.ServicePackage,
 		outposts.ServicePackage,
 		pinpoint.ServicePackage,
 		pipes.ServicePackage,
@@ -364,12 +378,15 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		rekognition.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
+		robomaker.ServicePackage,
 		rolesanywhere.ServicePackage,
 		route53.ServicePackage,
 		route53domains.ServicePackage,
+		route53recoverycluster.ServicePackage,
 		route53recoverycontrolconfig.ServicePackage,
 		route53recoveryreadiness.ServicePackage,
 		route53resolver.ServicePackage,
@@ -393,6 +410,8 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		shield.ServicePackage,
 		signer.ServicePackage,
 		simpledb.ServicePackage,
+		simspaceweaver.ServicePackage,
+		snowball.ServicePackage,
 		sns.ServicePackage,
 		sqs.ServicePackage,
 		ssm.ServicePackage,
@@ -404,6 +423,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		swf.ServicePackage,
 		synthetics.ServicePackage,
 		timestreamwrite.ServicePackage,
+		tnb.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
 		vpclattice.ServicePackage,
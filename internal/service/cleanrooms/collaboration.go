@@ -0,0 +1,383 @@
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_collaboration", name="Collaboration")
+// @Tags(identifierAttribute="arn")
+func ResourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollaborationCreate,
+		ReadWithoutTimeout:   resourceCollaborationRead,
+		UpdateWithoutTimeout: resourceCollaborationUpdate,
+		DeleteWithoutTimeout: resourceCollaborationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"creator_member_abilities": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.MemberAbility](),
+				},
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"member_abilities": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.MemberAbility](),
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CollaborationQueryLogStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameCollaboration = "Collaboration"
+)
+
+func resourceCollaborationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	name := d.Get("name").(string)
+	in := &cleanrooms.CreateCollaborationInput{
+		CreatorDisplayName:     aws.String(d.Get("creator_display_name").(string)),
+		CreatorMemberAbilities: flex.ExpandStringyValueList[types.MemberAbility](d.Get("creator_member_abilities").([]interface{})),
+		Description:            aws.String(d.Get("description").(string)),
+		Members:                expandMemberSpecifications(d.Get("member").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		QueryLogStatus:         types.CollaborationQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                   GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_encryption_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.DataEncryptionMetadata = expandDataEncryptionMetadata(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	out, err := conn.CreateCollaboration(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameCollaboration, name, err)
+	}
+
+	if out == nil || out.Collaboration == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameCollaboration, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Collaboration.Id))
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	collaboration, err := findCollaborationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Collaboration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameCollaboration, d.Id(), err)
+	}
+
+	d.Set("arn", collaboration.Arn)
+	d.Set("create_time", aws.ToTime(collaboration.CreateTime).Format(time.RFC3339))
+	d.Set("creator_display_name", collaboration.CreatorDisplayName)
+	if collaboration.DataEncryptionMetadata != nil {
+		if err := d.Set("data_encryption_metadata", []interface{}{flattenDataEncryptionMetadata(collaboration.DataEncryptionMetadata)}); err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+		}
+	} else {
+		d.Set("data_encryption_metadata", nil)
+	}
+	d.Set("description", collaboration.Description)
+	d.Set("name", collaboration.Name)
+	d.Set("query_log_status", collaboration.QueryLogStatus)
+	d.Set("update_time", aws.ToTime(collaboration.UpdateTime).Format(time.RFC3339))
+
+	members, err := findMembersByCollaborationID(ctx, conn, d.Id())
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameCollaboration, d.Id(), err)
+	}
+
+	creatorAccountID := aws.ToString(collaboration.CreatorAccountId)
+	var tfList []interface{}
+	for _, member := range members {
+		if aws.ToString(member.AccountId) == creatorAccountID {
+			d.Set("creator_member_abilities", enum.Slice(member.Abilities...))
+			continue
+		}
+
+		tfList = append(tfList, flattenMemberSummary(member))
+	}
+	if err := d.Set("member", tfList); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCollaborationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	if d.HasChanges("description", "name") {
+		in := &cleanrooms.UpdateCollaborationInput{
+			CollaborationIdentifier: aws.String(d.Id()),
+			Description:             aws.String(d.Get("description").(string)),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		if _, err := conn.UpdateCollaboration(ctx, in); err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameCollaboration, d.Id(), err)
+		}
+	}
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	log.Printf("[INFO] Deleting Clean Rooms Collaboration: %s", d.Id())
+	_, err := conn.DeleteCollaboration(ctx, &cleanrooms.DeleteCollaborationInput{
+		CollaborationIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.AccessDeniedException](err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameCollaboration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findCollaborationByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.Collaboration, error) {
+	in := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetCollaboration(ctx, in)
+
+	// A deleted collaboration is reported as access denied.
+	if errs.IsA[*types.AccessDeniedException](err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Collaboration, nil
+}
+
+func findMembersByCollaborationID(ctx context.Context, conn *cleanrooms.Client, id string) ([]types.MemberSummary, error) {
+	in := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var output []types.MemberSummary
+
+	pages := cleanrooms.NewListMembersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MemberSummaries...)
+	}
+
+	return output, nil
+}
+
+func expandDataEncryptionMetadata(tfMap map[string]interface{}) *types.DataEncryptionMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &types.DataEncryptionMetadata{
+		AllowCleartext:                        aws.Bool(tfMap["allow_clear_text"].(bool)),
+		AllowDuplicates:                       aws.Bool(tfMap["allow_duplicates"].(bool)),
+		AllowJoinsOnColumnsWithDifferentNames: aws.Bool(tfMap["allow_joins_on_columns_with_different_names"].(bool)),
+		PreserveNulls:                         aws.Bool(tfMap["preserve_nulls"].(bool)),
+	}
+}
+
+func flattenDataEncryptionMetadata(apiObject *types.DataEncryptionMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"allow_clear_text": aws.ToBool(apiObject.AllowCleartext),
+		"allow_duplicates": aws.ToBool(apiObject.AllowDuplicates),
+		"allow_joins_on_columns_with_different_names": aws.ToBool(apiObject.AllowJoinsOnColumnsWithDifferentNames),
+		"preserve_nulls": aws.ToBool(apiObject.PreserveNulls),
+	}
+}
+
+func expandMemberSpecifications(tfList []interface{}) []types.MemberSpecification {
+	apiObjects := make([]types.MemberSpecification, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.MemberSpecification{
+			AccountId:       aws.String(tfMap["account_id"].(string)),
+			DisplayName:     aws.String(tfMap["display_name"].(string)),
+			MemberAbilities: flex.ExpandStringyValueList[types.MemberAbility](tfMap["member_abilities"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMemberSummary(apiObject types.MemberSummary) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":       aws.ToString(apiObject.AccountId),
+		"display_name":     aws.ToString(apiObject.DisplayName),
+		"member_abilities": enum.Slice(apiObject.Abilities...),
+		"status":           string(apiObject.Status),
+	}
+}
@@ -0,0 +1,425 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_column": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraint": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"join_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		// Changing the analysis rule type requires a new analysis rule.
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("aggregation", analysisRulePolicyTypeChanged),
+			customdiff.ForceNewIfChange("list", analysisRulePolicyTypeChanged),
+		),
+	}
+}
+
+func analysisRulePolicyTypeChanged(_ context.Context, old, new, meta interface{}) bool {
+	return len(old.([]interface{})) != len(new.([]interface{}))
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	tableID := d.Get("configured_table_id").(string)
+	ruleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	id, err := flex.FlattenResourceId([]string{tableID, string(ruleType)}, configuredTableAnalysisRuleIDPartCount, false)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionFlatteningResourceId, ResNameConfiguredTableAnalysisRule, tableID, err)
+	}
+
+	in := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(tableID),
+	}
+
+	if _, err := conn.CreateConfiguredTableAnalysisRule(ctx, in); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionExpandingResourceId, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	tableID, ruleType := parts[0], types.ConfiguredTableAnalysisRuleType(parts[1])
+	rule, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, tableID, ruleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	d.Set("configured_table_id", rule.ConfiguredTableId)
+	d.Set("create_time", aws.ToTime(rule.CreateTime).Format(time.RFC3339))
+	d.Set("type", rule.Type)
+	d.Set("update_time", aws.ToTime(rule.UpdateTime).Format(time.RFC3339))
+
+	d.Set("aggregation", nil)
+	d.Set("list", nil)
+	if v, ok := rule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			if err := d.Set("aggregation", []interface{}{flattenAnalysisRuleAggregation(&v.Value)}); err != nil {
+				return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+			}
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			if err := d.Set("list", []interface{}{flattenAnalysisRuleList(&v.Value)}); err != nil {
+				return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	ruleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	in := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+	}
+
+	if _, err := conn.UpdateConfiguredTableAnalysisRule(ctx, in); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionExpandingResourceId, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Analysis Rule: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(parts[1]),
+		ConfiguredTableIdentifier: aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, tableID string, ruleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(tableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData) (types.ConfiguredTableAnalysisRuleType, types.ConfiguredTableAnalysisRulePolicy) {
+	if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeAggregation, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeList, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	return "", nil
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		DimensionColumns: flex.ExpandStringValueList(tfMap["dimension_columns"].([]interface{})),
+		JoinColumns:      flex.ExpandStringValueList(tfMap["join_columns"].([]interface{})),
+		ScalarFunctions:  flex.ExpandStringyValueList[types.ScalarFunctions](tfMap["scalar_functions"].([]interface{})),
+	}
+
+	for _, tfMapRaw := range tfMap["aggregate_column"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.AggregateColumns = append(apiObject.AggregateColumns, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueList(tfMap["column_names"].([]interface{})),
+			Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+		})
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	for _, tfMapRaw := range tfMap["output_constraint"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.OutputConstraints = append(apiObject.OutputConstraints, types.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+			Type:       types.AggregationType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dimension_columns": apiObject.DimensionColumns,
+		"join_columns":      apiObject.JoinColumns,
+		"join_required":     string(apiObject.JoinRequired),
+		"scalar_functions":  enum.Slice(apiObject.ScalarFunctions...),
+	}
+
+	var aggregateColumns []interface{}
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     string(v.Function),
+		})
+	}
+	tfMap["aggregate_column"] = aggregateColumns
+
+	var outputConstraints []interface{}
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.ToString(v.ColumnName),
+			"minimum":     aws.ToInt32(v.Minimum),
+			"type":        string(v.Type),
+		})
+	}
+	tfMap["output_constraint"] = outputConstraints
+
+	return tfMap
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	return types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueList(tfMap["join_columns"].([]interface{})),
+		ListColumns: flex.ExpandStringValueList(tfMap["list_columns"].([]interface{})),
+	}
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"join_columns": apiObject.JoinColumns,
+		"list_columns": apiObject.ListColumns,
+	}
+}
@@ -0,0 +1,10 @@
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	FindCollaborationByID                       = findCollaborationByID
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindConfiguredTableByID                     = findConfiguredTableByID
+	FindMembershipByID                          = findMembershipByID
+)
@@ -11,9 +11,10 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			"basic":      testAccCluster_basic,
-			"disappears": testAccCluster_disappears,
-			"tags":       testAccCluster_tags,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"basic":                 testAccCluster_basic,
+			"disappears":            testAccCluster_disappears,
+			"tags":                  testAccCluster_tags,
 		},
 		"Hsm": {
 			"availabilityZone": testAccHSM_AvailabilityZone,
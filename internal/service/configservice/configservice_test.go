@@ -98,6 +98,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"migrateParameters": testAccRemediationConfiguration_migrateParameters,
 			"recreates":         testAccRemediationConfiguration_recreates,
 			"updates":           testAccRemediationConfiguration_updates,
+			"validation":        testAccRemediationConfiguration_validation,
 			"values":            testAccRemediationConfiguration_values,
 		},
 	}
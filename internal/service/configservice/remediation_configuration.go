@@ -39,6 +39,8 @@ func ResourceRemediationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRemediationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -100,7 +102,7 @@ func ResourceRemediationConfiguration() *schema.Resource {
 						"resource_value": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 256),
+							ValidateFunc: validation.StringInSlice(configservice.ResourceValueType_Values(), false),
 						},
 						"static_value": {
 							Type:     schema.TypeString,
@@ -142,6 +144,65 @@ func ResourceRemediationConfiguration() *schema.Resource {
 	}
 }
 
+func resourceRemediationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.Get("execution_controls").([]interface{}); ok && len(v) > 0 {
+		tfMap, _ := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["ssm_controls"].([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+			return errors.New("execution_controls: ssm_controls must be specified")
+		}
+	}
+
+	if !diff.NewValueKnown("parameter") {
+		return nil
+	}
+
+	var resourceValueParameters []string
+
+	for i, tfMapRaw := range diff.Get("parameter").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		known := true
+		for _, k := range []string{"resource_value", "static_value", "static_values"} {
+			if !diff.NewValueKnown(fmt.Sprintf("parameter.%d.%s", i, k)) {
+				known = false
+			}
+		}
+
+		n := 0
+		if v, ok := tfMap["resource_value"].(string); ok && v != "" {
+			resourceValueParameters = append(resourceValueParameters, name)
+			n++
+		}
+		if v, ok := tfMap["static_value"].(string); ok && v != "" {
+			n++
+		}
+		if v, ok := tfMap["static_values"].([]interface{}); ok && len(v) > 0 {
+			n++
+		}
+
+		if n > 1 {
+			return fmt.Errorf("parameter %q: only one of resource_value, static_value or static_values can be specified", name)
+		}
+
+		if n == 0 && known {
+			return fmt.Errorf("parameter %q: one of resource_value, static_value or static_values must be specified", name)
+		}
+	}
+
+	// The resource ID of the noncompliant resource can only be passed to a single parameter.
+	if len(resourceValueParameters) > 1 {
+		return fmt.Errorf("only one parameter can be set to the resource ID (resource_value), got: %v", resourceValueParameters)
+	}
+
+	return nil
+}
+
 func resourceRemediationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ConfigServiceConn()
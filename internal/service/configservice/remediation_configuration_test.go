@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -234,6 +235,43 @@ func testAccRemediationConfiguration_updates(t *testing.T) {
 	})
 }
 
+func testAccRemediationConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRemediationConfigurationConfig_validationMultipleValues(),
+				ExpectError: regexp.MustCompile(`only one of resource_value, static_value or static_values can be specified`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_validationNoValue(),
+				ExpectError: regexp.MustCompile(`one of resource_value, static_value or static_values must be specified`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_validationMultipleResourceValues(),
+				ExpectError: regexp.MustCompile(`only one parameter can be set to the resource ID`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_validationExecutionControls(),
+				ExpectError: regexp.MustCompile(`execution_controls: ssm_controls must be specified`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_validationSSMControls(0, 10),
+				ExpectError: regexp.MustCompile(`expected execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage to be in the range \(1 - 100\)`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_validationSSMControls(10, 101),
+				ExpectError: regexp.MustCompile(`expected execution_controls.0.ssm_controls.0.error_percentage to be in the range \(1 - 100\)`),
+			},
+		},
+	})
+}
+
 func testAccRemediationConfiguration_values(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rc configservice.RemediationConfiguration
@@ -687,3 +725,91 @@ EOF
 }
 `, rName, sseAlgorithm, randAttempts, randSeconds, randExecPct, randErrorPct, automatic)
 }
+
+func testAccRemediationConfigurationConfig_validationMultipleValues() string {
+	return `
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "test"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+    static_value   = "test"
+  }
+}
+`
+}
+
+func testAccRemediationConfigurationConfig_validationNoValue() string {
+	return `
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "test"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name = "BucketName"
+  }
+}
+`
+}
+
+func testAccRemediationConfigurationConfig_validationMultipleResourceValues() string {
+	return `
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "test"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+  parameter {
+    name           = "ResourceId"
+    resource_value = "RESOURCE_ID"
+  }
+}
+`
+}
+
+func testAccRemediationConfigurationConfig_validationExecutionControls() string {
+	return `
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "test"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+
+  execution_controls {}
+}
+`
+}
+
+func testAccRemediationConfigurationConfig_validationSSMControls(concurrentPct, errorPct int) string {
+	return fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "test"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+
+  execution_controls {
+    ssm_controls {
+      concurrent_execution_rate_percentage = %[1]d
+      error_percentage                     = %[2]d
+    }
+  }
+}
+`, concurrentPct, errorPct)
+}
@@ -169,6 +169,10 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"nodes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -190,6 +194,10 @@ func ResourceCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -336,6 +344,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
 		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
 		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+		d.Set("cluster_endpoint_url", c.ClusterDiscoveryEndpoint.URL)
 	}
 
 	d.Set("subnet_group_name", c.SubnetGroup)
@@ -480,6 +489,7 @@ func setClusterNodeData(d *schema.ResourceData, c *dax.Cluster) error {
 			"address":           aws.StringValue(node.Endpoint.Address),
 			"port":              aws.Int64Value(node.Endpoint.Port),
 			"availability_zone": aws.StringValue(node.AvailabilityZone),
+			"url":               aws.StringValue(node.Endpoint.URL),
 		})
 	}
 
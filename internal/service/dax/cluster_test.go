@@ -57,6 +57,10 @@ func TestAccDAXCluster_basic(t *testing.T) {
 						resourceName, "configuration_endpoint", regexp.MustCompile(`:\d+$`)),
 					resource.TestCheckResourceAttrSet(
 						resourceName, "cluster_address"),
+					resource.TestMatchResourceAttr(
+						resourceName, "cluster_endpoint_url", regexp.MustCompile(`^dax://`)),
+					resource.TestMatchResourceAttr(
+						resourceName, "nodes.0.url", regexp.MustCompile(`^dax://`)),
 					resource.TestMatchResourceAttr(
 						resourceName, "port", regexp.MustCompile(`^\d+$`)),
 					resource.TestCheckResourceAttr(
@@ -242,6 +246,8 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dc),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", clusterEndpointEncryptionType),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexp.MustCompile(`^daxs://`)),
+					resource.TestMatchResourceAttr(resourceName, "nodes.0.url", regexp.MustCompile(`^daxs://`)),
 				),
 			},
 			{
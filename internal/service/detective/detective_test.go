@@ -12,9 +12,10 @@ func TestAccDetective_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Graph": {
-			"basic":      testAccGraph_basic,
-			"disappears": testAccGraph_disappears,
-			"tags":       testAccGraph_tags,
+			"basic":               testAccGraph_basic,
+			"datasource_packages": testAccGraph_datasourcePackages,
+			"disappears":          testAccGraph_disappears,
+			"tags":                testAccGraph_tags,
 		},
 		"InvitationAccepter": {
 			"basic": testAccInvitationAccepter_basic,
@@ -24,6 +25,12 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear": testAccMember_disappears,
 			"message":   testAccMember_message,
 		},
+		"OrganizationAdminAccount": {
+			"basic": testAccOrganizationAdminAccount_basic,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccOrganizationConfiguration_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGraphByARN(ctx context.Context, conn *detective.Detective, arn string) (*detective.Graph, error) {
@@ -129,3 +130,121 @@ func FindMemberByGraphARNAndAccountID(ctx context.Context, conn *detective.Detec
 
 	return result, nil
 }
+
+// FindDatasourcePackageIngestStatesByGraphARN returns the ingest state of each datasource package
+// for the specified behavior graph, keyed by datasource package name.
+func FindDatasourcePackageIngestStatesByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (map[string]string, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	result := make(map[string]string)
+
+	err := conn.ListDatasourcePackagesPagesWithContext(ctx, input, func(page *detective.ListDatasourcePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for name, detail := range page.DatasourcePackages {
+			if detail == nil {
+				continue
+			}
+
+			result[name] = aws.StringValue(detail.DatasourcePackageIngestState)
+		}
+
+		return !lastPage
+	})
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FindStartedDatasourcePackagesByGraphARN returns the names of the datasource packages
+// whose ingest state is STARTED for the specified behavior graph.
+func FindStartedDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) ([]string, error) {
+	states, err := FindDatasourcePackageIngestStatesByGraphARN(ctx, conn, graphARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+
+	for name, state := range states {
+		if state == detective.DatasourcePackageIngestStateStarted {
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
+
+func FindOrganizationAdminAccountByAccountID(ctx context.Context, conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	input := &detective.ListOrganizationAdminAccountsInput{}
+
+	var result *detective.Administrator
+
+	err := conn.ListOrganizationAdminAccountsPagesWithContext(ctx, input, func(page *detective.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, administrator := range page.Administrators {
+			if administrator == nil {
+				continue
+			}
+
+			if aws.StringValue(administrator.AccountId) == accountID {
+				result = administrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &retry.NotFoundError{
+			Message:     fmt.Sprintf("No detective organization admin account found with accountID %q", accountID),
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindOrganizationConfigurationByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (*detective.DescribeOrganizationConfigurationOutput, error) {
+	input := &detective.DescribeOrganizationConfigurationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
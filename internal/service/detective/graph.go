@@ -2,15 +2,20 @@ package detective
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -33,6 +38,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(detective.DatasourcePackage_Values(), false),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,10 +54,28 @@ func ResourceGraph() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffGraphDatasourcePackages,
+		),
 	}
 }
 
+// customizeDiffGraphDatasourcePackages rejects the removal of datasource packages
+// as a started datasource package cannot be stopped via the Detective API.
+func customizeDiffGraphDatasourcePackages(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("datasource_packages") || !diff.NewValueKnown("datasource_packages") {
+		return nil
+	}
+
+	o, n := diff.GetChange("datasource_packages")
+	if removed := o.(*schema.Set).Difference(n.(*schema.Set)); removed.Len() > 0 {
+		return fmt.Errorf("datasource packages cannot be disabled once started: %v", flex.ExpandStringValueSet(removed))
+	}
+
+	return nil
+}
+
 func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DetectiveConn()
 
@@ -76,6 +108,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.StringValue(output.GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringSet(v.(*schema.Set))); err != nil {
+			return diag.Errorf("error creating detective Graph (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceGraphRead(ctx, d, meta)
 }
 
@@ -95,14 +133,55 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("created_time", aws.TimeValue(resp.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", resp.Arn)
 
+	packages, err := FindStartedDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading detective Graph (%s) datasource packages: %s", d.Id(), err)
+	}
+
+	// The core datasource package is always started, so only report it when configured.
+	if !d.Get("datasource_packages").(*schema.Set).Contains(detective.DatasourcePackageDetectiveCore) {
+		packages = tfslices.RemoveAll(packages, detective.DatasourcePackageDetectiveCore)
+	}
+
+	d.Set("datasource_packages", packages)
+
 	return nil
 }
 
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	if d.HasChange("datasource_packages") {
+		o, n := d.GetChange("datasource_packages")
+
+		if added := n.(*schema.Set).Difference(o.(*schema.Set)); added.Len() > 0 {
+			if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringSet(added)); err != nil {
+				return diag.Errorf("error updating detective Graph (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceGraphRead(ctx, d, meta)
 }
 
+func updateDatasourcePackages(ctx context.Context, conn *detective.Detective, graphARN string, packages []*string) error {
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: packages,
+		GraphArn:           aws.String(graphARN),
+	}
+
+	if _, err := conn.UpdateDatasourcePackagesWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating datasource packages: %w", err)
+	}
+
+	if err := waitDatasourcePackagesStarted(ctx, conn, graphARN, aws.StringValueSlice(packages)); err != nil {
+		return fmt.Errorf("waiting for datasource packages to start: %w", err)
+	}
+
+	return nil
+}
+
 func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DetectiveConn()
 
@@ -3,6 +3,7 @@ package detective_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -99,6 +100,43 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	ctx := acctest.Context(t)
+	var graph1, graph2 detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph1),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "DETECTIVE_CORE"),
+				),
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE", "EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph2),
+					testAccCheckGraphNotRecreated(&graph1, &graph2),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "DETECTIVE_CORE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "EKS_AUDIT"),
+				),
+			},
+			{
+				Config:      testAccGraphConfig_datasourcePackages(`"DETECTIVE_CORE"`),
+				ExpectError: regexp.MustCompile(`datasource packages cannot be disabled once started`),
+			},
+		},
+	})
+}
+
 func testAccGraph_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var graphOutput detective.Graph
@@ -190,6 +228,14 @@ resource "aws_detective_graph" "test" {}
 `
 }
 
+func testAccGraphConfig_datasourcePackages(packages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, packages)
+}
+
 func testAccGraphConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_detective_graph" "test" {
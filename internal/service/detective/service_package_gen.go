@@ -41,6 +41,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMember,
 			TypeName: "aws_detective_member",
 		},
+		{
+			Factory:  ResourceOrganizationAdminAccount,
+			TypeName: "aws_detective_organization_admin_account",
+		},
+		{
+			Factory:  ResourceOrganizationConfiguration,
+			TypeName: "aws_detective_organization_configuration",
+		},
 	}
 }
 
@@ -24,3 +24,28 @@ func MemberStatus(ctx context.Context, conn *detective.Detective, graphARN, admi
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// statusDatasourcePackages fetches the ingest state of the specified datasource packages.
+// The STARTED state is only returned once all of the datasource packages have started.
+func statusDatasourcePackages(ctx context.Context, conn *detective.Detective, graphARN string, packages []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		states, err := FindDatasourcePackageIngestStatesByGraphARN(ctx, conn, graphARN)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, name := range packages {
+			switch state := states[name]; state {
+			case detective.DatasourcePackageIngestStateStarted:
+			case "":
+				// Not yet listed for the behavior graph.
+				return states, detective.DatasourcePackageIngestStateDisabled, nil
+			default:
+				return states, state, nil
+			}
+		}
+
+		return states, detective.DatasourcePackageIngestStateStarted, nil
+	}
+}
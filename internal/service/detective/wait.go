@@ -13,13 +13,16 @@ const (
 	GraphOperationTimeout = 4 * time.Minute
 	// MemberStatusPropagationTimeout Maximum amount of time to wait for a detective member status to return Invited
 	MemberStatusPropagationTimeout = 4 * time.Minute
+	// DatasourcePackagesStartedTimeout Maximum amount of time to wait for detective datasource packages to be started
+	DatasourcePackagesStartedTimeout = 10 * time.Minute
 )
 
-// MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited
+// MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited,
+// or Enabled for organization accounts that are enrolled without an invitation
 func MemberStatusUpdated(ctx context.Context, conn *detective.Detective, graphARN, adminAccountID, expectedValue string) (*detective.MemberDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{detective.MemberStatusVerificationInProgress},
-		Target:  []string{detective.MemberStatusInvited},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled},
 		Refresh: MemberStatus(ctx, conn, graphARN, adminAccountID),
 		Timeout: MemberStatusPropagationTimeout,
 	}
@@ -32,3 +35,17 @@ func MemberStatusUpdated(ctx context.Context, conn *detective.Detective, graphAR
 
 	return nil, err
 }
+
+// waitDatasourcePackagesStarted waits for the specified datasource packages of a graph to return STARTED.
+func waitDatasourcePackagesStarted(ctx context.Context, conn *detective.Detective, graphARN string, packages []string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{detective.DatasourcePackageIngestStateDisabled, detective.DatasourcePackageIngestStateStopped},
+		Target:  []string{detective.DatasourcePackageIngestStateStarted},
+		Refresh: statusDatasourcePackages(ctx, conn, graphARN, packages),
+		Timeout: DatasourcePackagesStartedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,445 @@
+package drs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_drs_replication_configuration_template", name="Replication Configuration Template")
+// @Tags(identifierAttribute="arn")
+func ResourceReplicationConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceReplicationConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceReplicationConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceReplicationConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_default_security_group": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"bandwidth_throttling": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"create_public_ip": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"data_plane_routing": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDataPlaneRouting_Values(), false),
+			},
+			"default_large_staging_disk_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDefaultLargeStagingDiskType_Values(), false),
+			},
+			"ebs_encryption": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationEbsEncryption_Values(), false),
+			},
+			"ebs_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pit_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retention_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"rule_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"units": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(drs.PITPolicyRuleUnits_Values(), false),
+						},
+					},
+				},
+			},
+			"replication_server_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"staging_area_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_area_tags": tftags.TagsSchema(),
+			names.AttrTags:      tftags.TagsSchema(),
+			names.AttrTagsAll:   tftags.TagsSchemaComputed(),
+			"use_dedicated_replication_server": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceReplicationConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DRSConn()
+
+	input := &drs.CreateReplicationConfigurationTemplateInput{
+		AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+		BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+		CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+		DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+		DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+		EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+		PitPolicy:                           expandPITPolicyRules(d.Get("pit_policy").([]interface{})),
+		ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+		ReplicationServersSecurityGroupsIDs: flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{})),
+		StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+		StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+		Tags:                                GetTagsIn(ctx),
+		UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+	}
+
+	if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+		input.EbsEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateReplicationConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DRS Replication Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationConfigurationTemplateID))
+
+	return append(diags, resourceReplicationConfigurationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceReplicationConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DRSConn()
+
+	output, err := FindReplicationConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DRS Replication Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DRS Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("associate_default_security_group", output.AssociateDefaultSecurityGroup)
+	d.Set("bandwidth_throttling", output.BandwidthThrottling)
+	d.Set("create_public_ip", output.CreatePublicIP)
+	d.Set("data_plane_routing", output.DataPlaneRouting)
+	d.Set("default_large_staging_disk_type", output.DefaultLargeStagingDiskType)
+	d.Set("ebs_encryption", output.EbsEncryption)
+	d.Set("ebs_encryption_key_arn", output.EbsEncryptionKeyArn)
+	if err := d.Set("pit_policy", flattenPITPolicyRules(output.PitPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pit_policy: %s", err)
+	}
+	d.Set("replication_server_instance_type", output.ReplicationServerInstanceType)
+	d.Set("replication_servers_security_groups_ids", aws.StringValueSlice(output.ReplicationServersSecurityGroupsIDs))
+	d.Set("staging_area_subnet_id", output.StagingAreaSubnetId)
+	d.Set("staging_area_tags", aws.StringValueMap(output.StagingAreaTags))
+	d.Set("use_dedicated_replication_server", output.UseDedicatedReplicationServer)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceReplicationConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DRSConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &drs.UpdateReplicationConfigurationTemplateInput{
+			ReplicationConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("associate_default_security_group") {
+			input.AssociateDefaultSecurityGroup = aws.Bool(d.Get("associate_default_security_group").(bool))
+		}
+
+		if d.HasChange("bandwidth_throttling") {
+			input.BandwidthThrottling = aws.Int64(int64(d.Get("bandwidth_throttling").(int)))
+		}
+
+		if d.HasChange("create_public_ip") {
+			input.CreatePublicIP = aws.Bool(d.Get("create_public_ip").(bool))
+		}
+
+		if d.HasChange("data_plane_routing") {
+			input.DataPlaneRouting = aws.String(d.Get("data_plane_routing").(string))
+		}
+
+		if d.HasChange("default_large_staging_disk_type") {
+			input.DefaultLargeStagingDiskType = aws.String(d.Get("default_large_staging_disk_type").(string))
+		}
+
+		if d.HasChange("ebs_encryption") {
+			input.EbsEncryption = aws.String(d.Get("ebs_encryption").(string))
+		}
+
+		if d.HasChange("ebs_encryption_key_arn") {
+			input.EbsEncryptionKeyArn = aws.String(d.Get("ebs_encryption_key_arn").(string))
+		}
+
+		if d.HasChange("pit_policy") {
+			input.PitPolicy = expandPITPolicyRules(d.Get("pit_policy").([]interface{}))
+		}
+
+		if d.HasChange("replication_server_instance_type") {
+			input.ReplicationServerInstanceType = aws.String(d.Get("replication_server_instance_type").(string))
+		}
+
+		if d.HasChange("replication_servers_security_groups_ids") {
+			input.ReplicationServersSecurityGroupsIDs = flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{}))
+		}
+
+		if d.HasChange("staging_area_subnet_id") {
+			input.StagingAreaSubnetId = aws.String(d.Get("staging_area_subnet_id").(string))
+		}
+
+		if d.HasChange("staging_area_tags") {
+			input.StagingAreaTags = flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{}))
+		}
+
+		if d.HasChange("use_dedicated_replication_server") {
+			input.UseDedicatedReplicationServer = aws.Bool(d.Get("use_dedicated_replication_server").(bool))
+		}
+
+		_, err := conn.UpdateReplicationConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DRS Replication Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReplicationConfigurationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceReplicationConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DRSConn()
+
+	log.Printf("[DEBUG] Deleting DRS Replication Configuration Template: %s", d.Id())
+	_, err := conn.DeleteReplicationConfigurationTemplateWithContext(ctx, &drs.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DRS Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindReplicationConfigurationTemplateByID(ctx context.Context, conn *drs.Drs, id string) (*drs.ReplicationConfigurationTemplate, error) {
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := findReplicationConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findReplicationConfigurationTemplates(ctx context.Context, conn *drs.Drs, input *drs.DescribeReplicationConfigurationTemplatesInput) ([]*drs.ReplicationConfigurationTemplate, error) {
+	var output []*drs.ReplicationConfigurationTemplate
+
+	err := conn.DescribeReplicationConfigurationTemplatesPagesWithContext(ctx, input, func(page *drs.DescribeReplicationConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandPITPolicyRule(tfMap map[string]interface{}) *drs.PITPolicyRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &drs.PITPolicyRule{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retention_duration"].(int); ok && v != 0 {
+		apiObject.RetentionDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rule_id"].(int); ok && v != 0 {
+		apiObject.RuleID = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["units"].(string); ok && v != "" {
+		apiObject.Units = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPITPolicyRules(tfList []interface{}) []*drs.PITPolicyRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*drs.PITPolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandPITPolicyRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPITPolicyRule(apiObject *drs.PITPolicyRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Interval; v != nil {
+		tfMap["interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RetentionDuration; v != nil {
+		tfMap["retention_duration"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RuleID; v != nil {
+		tfMap["rule_id"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Units; v != nil {
+		tfMap["units"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenPITPolicyRules(apiObjects []*drs.PITPolicyRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenPITPolicyRule(apiObject))
+	}
+
+	return tfList
+}
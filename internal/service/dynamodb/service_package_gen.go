@@ -54,6 +54,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceTableExport,
+			TypeName: "aws_dynamodb_table_export",
+		},
+		{
+			Factory:  ResourceTableImport,
+			TypeName: "aws_dynamodb_table_import",
+		},
 		{
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
@@ -270,6 +270,11 @@ func ResourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"deletion_protection_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -300,6 +305,12 @@ func ResourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"table_class_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
+						},
 					},
 				},
 			},
@@ -774,7 +785,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			TableName:                   aws.String(d.Id()),
 		}
 
-		if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+		if err := updateTableGSI(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionDeleting, ResNameTable, d.Id(), fmt.Errorf("GSI (%s): %w", idxName, err))
 		}
 
@@ -885,7 +896,9 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// Phase 3 of Global Secondary Index Operations: Create Only
-	// Only 1 online index can be created simultaneously per table
+	//  * Only 1 online index can be created simultaneously per table
+	//  * Indexes whose key schema or projection changed were deleted in
+	//    Phase 1 and are recreated here with the new definition
 	for _, gsiUpdate := range gsiUpdates {
 		if gsiUpdate.Create == nil {
 			continue
@@ -898,7 +911,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			TableName:                   aws.String(d.Id()),
 		}
 
-		if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+		if err := updateTableGSI(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTable, d.Id(), fmt.Errorf("creating GSI (%s): %w", idxName, err))
 		}
 
@@ -1106,6 +1119,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+			replicaInput.TableClassOverride = aws.String(v)
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1120,7 +1137,8 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		//   kms_key_arn can't be updated - remove/add replica
 		//   propagate_tags - handled elsewhere
 		//   point_in_time_recovery - handled elsewhere
-		// if provisioned_throughput_override or table_class_override were added, they could be updated here
+		//   deletion_protection_enabled - handled elsewhere
+		// if provisioned_throughput_override were added, it could be updated here
 		if !create {
 			var replicaInput = &dynamodb.UpdateReplicationGroupMemberAction{}
 			if v, ok := tfMap["region_name"].(string); ok && v != "" {
@@ -1131,6 +1149,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 				replicaInput.KMSMasterKeyId = aws.String(v)
 			}
 
+			if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+				replicaInput.TableClassOverride = aws.String(v)
+			}
+
 			input = &dynamodb.UpdateTableInput{
 				TableName: aws.String(tableName),
 				ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1186,6 +1208,12 @@ func createReplicas(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		if err = updatePITR(ctx, conn, tableName, tfMap["point_in_time_recovery"].(bool), tfMap["region_name"].(string), tfVersion, timeout); err != nil {
 			return fmt.Errorf("updating replica (%s) point in time recovery: %w", tfMap["region_name"].(string), err)
 		}
+
+		if v, ok := tfMap["deletion_protection_enabled"].(bool); ok && v {
+			if err = updateReplicaDeletionProtection(ctx, conn, tableName, tfMap["region_name"].(string), v, tfVersion, timeout); err != nil {
+				return fmt.Errorf("updating replica (%s) deletion protection: %w", tfMap["region_name"].(string), err)
+			}
+		}
 	}
 
 	return nil
@@ -1303,6 +1331,38 @@ func updatePITR(ctx context.Context, conn *dynamodb.DynamoDB, tableName string,
 	return nil
 }
 
+func updateReplicaDeletionProtection(ctx context.Context, conn *dynamodb.DynamoDB, tableName, region string, enabled bool, tfVersion string, timeout time.Duration) error {
+	// deletion protection must be modified from region where the replica resides
+	log.Printf("[DEBUG] Updating DynamoDB replica (%s) deletion protection to %v", region, enabled)
+	input := &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(enabled),
+		TableName:                 aws.String(tableName),
+	}
+
+	if aws.StringValue(conn.Config.Region) != region {
+		session, err := conns.NewSessionForRegion(&conn.Config, region, tfVersion)
+		if err != nil {
+			return fmt.Errorf("new session for region (%s): %w", region, err)
+		}
+
+		conn = dynamodb.New(session)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, maxDuration(replicaUpdateTimeout, timeout), func() (interface{}, error) {
+		return conn.UpdateTableWithContext(ctx, input)
+	}, dynamodb.ErrCodeResourceInUseException)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func updateReplica(ctx context.Context, d *schema.ResourceData, conn *dynamodb.DynamoDB, tfVersion string) error {
 	oRaw, nRaw := d.GetChange("replica")
 	o := oRaw.(*schema.Set)
@@ -1369,15 +1429,28 @@ func updateReplica(ctx context.Context, d *schema.ResourceData, conn *dynamodb.D
 				break
 			}
 
-			// just update PITR
+			// update the replica through the main table
+			if v := ma["table_class_override"].(string); v != "" && v != mr["table_class_override"].(string) {
+				if err := createReplicas(ctx, conn, d.Id(), []interface{}{ma}, tfVersion, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) table class: %w", ma["region_name"].(string), err)
+				}
+			}
+
+			// update PITR
 			if ma["point_in_time_recovery"].(bool) != mr["point_in_time_recovery"].(bool) {
 				if err := updatePITR(ctx, conn, d.Id(), ma["point_in_time_recovery"].(bool), ma["region_name"].(string), tfVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
 					return fmt.Errorf("updating replica (%s) point in time recovery: %w", ma["region_name"].(string), err)
 				}
-				break
 			}
 
-			// nothing changed, assuming propagate_tags changed so do nothing here
+			// update deletion protection
+			if ma["deletion_protection_enabled"].(bool) != mr["deletion_protection_enabled"].(bool) {
+				if err := updateReplicaDeletionProtection(ctx, conn, d.Id(), ma["region_name"].(string), ma["deletion_protection_enabled"].(bool), tfVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) deletion protection: %w", ma["region_name"].(string), err)
+				}
+			}
+
+			// otherwise, assuming propagate_tags changed so do nothing here
 			break
 		}
 	}
@@ -1511,6 +1584,38 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dyn
 	return ops, nil
 }
 
+// updateTableGSI performs a single Global Secondary Index operation, first waiting
+// for any in-flight table or index operation (e.g. an index backfill from a previous
+// apply) to complete.
+func updateTableGSI(ctx context.Context, conn *dynamodb.DynamoDB, input *dynamodb.UpdateTableInput, timeout time.Duration) error {
+	tableName := aws.StringValue(input.TableName)
+
+	if err := waitGSIsActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for DynamoDB Table (%s) indexes to become active: %w", tableName, err)
+	}
+
+	_, err := tfresource.RetryWhen(ctx, maxDuration(updateTableTimeout, timeout), func() (interface{}, error) {
+		return conn.UpdateTableWithContext(ctx, input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+			return true, err
+		}
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceInUseException) {
+			return true, err
+		}
+		if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created or deleted simultaneously") {
+			return true, err
+		}
+		if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	return err
+}
+
 func deleteTable(ctx context.Context, conn *dynamodb.DynamoDB, tableName string) error {
 	input := &dynamodb.DeleteTableInput{
 		TableName: aws.String(tableName),
@@ -1649,23 +1754,23 @@ func replicaPITR(ctx context.Context, conn *dynamodb.DynamoDB, tableName string,
 	return enabled, nil
 }
 
-func replicaStream(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, region string, tfVersion string) (string, string) {
+func replicaTable(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, region string, tfVersion string) *dynamodb.TableDescription {
 	// This does not return an error because it is attempting to add "Computed"-only information to replica - tolerating errors.
 	session, err := conns.NewSessionForRegion(&conn.Config, region, tfVersion)
 	if err != nil {
-		log.Printf("[WARN] Attempting to get replica (%s) stream information, ignoring encountered error: %s", tableName, err)
-		return "", ""
+		log.Printf("[WARN] Attempting to get replica (%s) information, ignoring encountered error: %s", tableName, err)
+		return nil
 	}
 
 	conn = dynamodb.New(session)
 
 	table, err := FindTableByName(ctx, conn, tableName)
 	if err != nil {
-		log.Printf("[WARN] When attempting to get replica (%s) stream information, ignoring encountered error: %s", tableName, err)
-		return "", ""
+		log.Printf("[WARN] When attempting to get replica (%s) information, ignoring encountered error: %s", tableName, err)
+		return nil
 	}
 
-	return aws.StringValue(table.LatestStreamArn), aws.StringValue(table.LatestStreamLabel)
+	return table
 }
 
 func addReplicaPITRs(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, tfVersion string, replicas []interface{}) ([]interface{}, error) {
@@ -1687,8 +1792,8 @@ func addReplicaPITRs(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 }
 
 func enrichReplicas(ctx context.Context, conn *dynamodb.DynamoDB, arn, tableName, tfVersion string, replicas []interface{}) ([]interface{}, error) {
-	// This non-standard approach is needed because PITR info for a replica
-	// must come from a region-specific connection.
+	// This non-standard approach is needed because stream and deletion protection
+	// info for a replica must come from a region-specific connection.
 	for i, replicaRaw := range replicas {
 		replica := replicaRaw.(map[string]interface{})
 
@@ -1698,9 +1803,14 @@ func enrichReplicas(ctx context.Context, conn *dynamodb.DynamoDB, arn, tableName
 		}
 		replica[names.AttrARN] = newARN
 
-		streamARN, streamLabel := replicaStream(ctx, conn, tableName, replica["region_name"].(string), tfVersion)
-		replica["stream_arn"] = streamARN
-		replica["stream_label"] = streamLabel
+		if table := replicaTable(ctx, conn, tableName, replica["region_name"].(string), tfVersion); table != nil {
+			replica["deletion_protection_enabled"] = aws.BoolValue(table.DeletionProtectionEnabled)
+			replica["stream_arn"] = aws.StringValue(table.LatestStreamArn)
+			replica["stream_label"] = aws.StringValue(table.LatestStreamLabel)
+		} else {
+			replica["stream_arn"] = ""
+			replica["stream_label"] = ""
+		}
 		replicas[i] = replica
 	}
 
@@ -1939,6 +2049,10 @@ func flattenReplicaDescription(apiObject *dynamodb.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.StringValue(apiObject.RegionName)
 	}
 
+	if apiObject.ReplicaTableClassSummary != nil {
+		tfMap["table_class_override"] = aws.StringValue(apiObject.ReplicaTableClassSummary.TableClass)
+	}
+
 	return tfMap
 }
 
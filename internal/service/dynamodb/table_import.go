@@ -0,0 +1,550 @@
+package dynamodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_table_import")
+func ResourceTableImport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableImportCreate,
+		ReadWithoutTimeout:   resourceTableImportRead,
+		DeleteWithoutTimeout: resourceTableImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(deleteTableTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_log_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.InputCompressionTypeNone,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+			},
+			"input_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+			},
+			"input_format_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1),
+									},
+									"header_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processed_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_owner": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_creation_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dynamodb.ScalarAttributeType_Values(), false),
+									},
+								},
+							},
+						},
+						"billing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dynamodb.BillingModeProvisioned,
+							ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
+						},
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hash_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"non_key_attributes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"projection_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dynamodb.ProjectionType_Values(), false),
+									},
+									"range_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"read_capacity": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"write_capacity": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"hash_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"range_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"read_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"server_side_encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrKMSKeyARN: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"write_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTableImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableCreationParameters := expandTableCreationParameters(d.Get("table_creation_parameters").([]interface{})[0].(map[string]interface{}))
+	tableName := aws.StringValue(tableCreationParameters.TableName)
+	input := &dynamodb.ImportTableInput{
+		ClientToken:             aws.String(id.UniqueId()),
+		InputCompressionType:    aws.String(d.Get("input_compression_type").(string)),
+		InputFormat:             aws.String(d.Get("input_format").(string)),
+		S3BucketSource:          expandS3BucketSource(d.Get("s3_bucket_source").([]interface{})[0].(map[string]interface{})),
+		TableCreationParameters: tableCreationParameters,
+	}
+
+	if v, ok := d.GetOk("input_format_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputFormatOptions = expandInputFormatOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := tfresource.RetryWhenAWSErrMessageContains(ctx, createTableTimeout, func() (interface{}, error) {
+		return conn.ImportTableWithContext(ctx, input)
+	}, dynamodb.ErrCodeLimitExceededException, "simultaneously")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	d.SetId(aws.StringValue(output.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn))
+
+	if _, err := waitTableImportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Import (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableImportRead(ctx, d, meta)...)
+}
+
+func resourceTableImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	output, err := FindTableImportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Import (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ImportArn)
+	d.Set("cloudwatch_log_group_arn", output.CloudWatchLogGroupArn)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("error_count", output.ErrorCount)
+	d.Set("import_status", output.ImportStatus)
+	d.Set("imported_item_count", output.ImportedItemCount)
+	d.Set("input_compression_type", output.InputCompressionType)
+	d.Set("input_format", output.InputFormat)
+	if output.InputFormatOptions != nil {
+		if err := d.Set("input_format_options", []interface{}{flattenInputFormatOptions(output.InputFormatOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting input_format_options: %s", err)
+		}
+	} else {
+		d.Set("input_format_options", nil)
+	}
+	d.Set("processed_item_count", output.ProcessedItemCount)
+	d.Set("processed_size_in_bytes", output.ProcessedSizeBytes)
+	if output.S3BucketSource != nil {
+		if err := d.Set("s3_bucket_source", []interface{}{flattenS3BucketSource(output.S3BucketSource)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3_bucket_source: %s", err)
+		}
+	} else {
+		d.Set("s3_bucket_source", nil)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("table_arn", output.TableArn)
+	if output.TableCreationParameters != nil {
+		if err := d.Set("table_creation_parameters", []interface{}{flattenTableCreationParameters(output.TableCreationParameters)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting table_creation_parameters: %s", err)
+		}
+	} else {
+		d.Set("table_creation_parameters", nil)
+	}
+
+	return diags
+}
+
+func resourceTableImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	// The import itself cannot be deleted. Delete the table that it created.
+	tableName := d.Get("table_creation_parameters.0.table_name").(string)
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) created by Table Import (%s)", tableName, d.Id())
+	err := deleteTable(ctx, conn, tableName)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	if _, err := waitTableDeleted(ctx, conn, tableName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table (%s) delete: %s", tableName, err)
+	}
+
+	return diags
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		csv := &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			csv.HeaderList = flex.ExpandStringList(v)
+		}
+
+		apiObject.Csv = csv
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	apiObject := &dynamodb.S3BucketSource{
+		S3Bucket: aws.String(tfMap["bucket"].(string)),
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTableCreationParameters(tfMap map[string]interface{}) *dynamodb.TableCreationParameters {
+	billingMode := tfMap["billing_mode"].(string)
+	apiObject := &dynamodb.TableCreationParameters{
+		AttributeDefinitions:  expandAttributes(tfMap["attribute"].(*schema.Set).List()),
+		BillingMode:           aws.String(billingMode),
+		KeySchema:             expandKeySchema(tfMap),
+		ProvisionedThroughput: expandProvisionedThroughput(tfMap, billingMode),
+		TableName:             aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			apiObject.GlobalSecondaryIndexes = append(apiObject.GlobalSecondaryIndexes, expandGlobalSecondaryIndex(tfMapRaw.(map[string]interface{}), billingMode))
+		}
+	}
+
+	if v, ok := tfMap["server_side_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SSESpecification = expandEncryptAtRestOptions(v)
+	}
+
+	return apiObject
+}
+
+func flattenInputFormatOptions(apiObject *dynamodb.InputFormatOptions) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Csv; v != nil {
+		tfMap["csv"] = []interface{}{map[string]interface{}{
+			"delimiter":   aws.StringValue(v.Delimiter),
+			"header_list": aws.StringValueSlice(v.HeaderList),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3BucketSource(apiObject *dynamodb.S3BucketSource) map[string]interface{} {
+	return map[string]interface{}{
+		"bucket":       aws.StringValue(apiObject.S3Bucket),
+		"bucket_owner": aws.StringValue(apiObject.S3BucketOwner),
+		"key_prefix":   aws.StringValue(apiObject.S3KeyPrefix),
+	}
+}
+
+func flattenTableCreationParameters(apiObject *dynamodb.TableCreationParameters) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"attribute":  flattenTableAttributeDefinitions(apiObject.AttributeDefinitions),
+		"table_name": aws.StringValue(apiObject.TableName),
+	}
+
+	if v := apiObject.BillingMode; v != nil {
+		tfMap["billing_mode"] = aws.StringValue(v)
+	} else {
+		tfMap["billing_mode"] = dynamodb.BillingModeProvisioned
+	}
+
+	for _, v := range apiObject.KeySchema {
+		switch aws.StringValue(v.KeyType) {
+		case dynamodb.KeyTypeHash:
+			tfMap["hash_key"] = aws.StringValue(v.AttributeName)
+		case dynamodb.KeyTypeRange:
+			tfMap["range_key"] = aws.StringValue(v.AttributeName)
+		}
+	}
+
+	if v := apiObject.ProvisionedThroughput; v != nil {
+		tfMap["read_capacity"] = aws.Int64Value(v.ReadCapacityUnits)
+		tfMap["write_capacity"] = aws.Int64Value(v.WriteCapacityUnits)
+	}
+
+	var gsis []interface{}
+	for _, v := range apiObject.GlobalSecondaryIndexes {
+		gsi := map[string]interface{}{
+			names.AttrName: aws.StringValue(v.IndexName),
+		}
+
+		for _, v := range v.KeySchema {
+			switch aws.StringValue(v.KeyType) {
+			case dynamodb.KeyTypeHash:
+				gsi["hash_key"] = aws.StringValue(v.AttributeName)
+			case dynamodb.KeyTypeRange:
+				gsi["range_key"] = aws.StringValue(v.AttributeName)
+			}
+		}
+
+		if v := v.Projection; v != nil {
+			gsi["projection_type"] = aws.StringValue(v.ProjectionType)
+			gsi["non_key_attributes"] = aws.StringValueSlice(v.NonKeyAttributes)
+		}
+
+		if v := v.ProvisionedThroughput; v != nil {
+			gsi["read_capacity"] = aws.Int64Value(v.ReadCapacityUnits)
+			gsi["write_capacity"] = aws.Int64Value(v.WriteCapacityUnits)
+		}
+
+		gsis = append(gsis, gsi)
+	}
+	tfMap["global_secondary_index"] = gsis
+
+	if v := apiObject.SSESpecification; v != nil {
+		tfMap["server_side_encryption"] = []interface{}{map[string]interface{}{
+			names.AttrEnabled:   aws.BoolValue(v.Enabled),
+			names.AttrKMSKeyARN: aws.StringValue(v.KMSMasterKeyId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,159 @@
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDynamoDBTableImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v dynamodb.ImportTableDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableImportExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "import_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "imported_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.0.delimiter", ";"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.0.header_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.0.key_prefix", "import/"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "table_arn", "dynamodb", fmt.Sprintf("table/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.billing_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckTableImportDestroy checks that the tables created by imports have been deleted.
+func testAccCheckTableImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_import" {
+				continue
+			}
+
+			tableName := rs.Primary.Attributes["table_creation_parameters.0.table_name"]
+			_, err := tfdynamodb.FindTableByName(ctx, conn, tableName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DynamoDB Table %s still exists", tableName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableImportExists(ctx context.Context, n string, v *dynamodb.ImportTableDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Import ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		output, err := tfdynamodb.FindTableImportByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableImportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = "one;1\ntwo;2\n"
+}
+
+resource "aws_dynamodb_table_import" "test" {
+  input_format = "CSV"
+
+  input_format_options {
+    csv {
+      delimiter   = ";"
+      header_list = ["pk", "value"]
+    }
+  }
+
+  s3_bucket_source {
+    bucket     = aws_s3_object.test.bucket
+    key_prefix = "import/"
+  }
+
+  table_creation_parameters {
+    table_name   = %[1]q
+    billing_mode = "PAY_PER_REQUEST"
+    hash_key     = "pk"
+
+    attribute {
+      name = "pk"
+      type = "S"
+    }
+  }
+}
+`, rName)
+}
@@ -1294,6 +1294,78 @@ func TestAccDynamoDBTable_gsiUpdateNonKeyAttributes(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_gsiUpdateProjectionType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf1, conf2, conf3 dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_gsiProjectionType(rName, "ALL", "ALL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att1-index",
+						"projection_type": "ALL",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att2-index",
+						"projection_type": "ALL",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_gsiProjectionType(rName, "KEYS_ONLY", "ALL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf2),
+					testAccCheckTableNotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att1-index",
+						"projection_type": "KEYS_ONLY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att2-index",
+						"projection_type": "ALL",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_gsiProjectionType(rName, "ALL", "KEYS_ONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf3),
+					testAccCheckTableNotRecreated(&conf2, &conf3),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att1-index",
+						"projection_type": "ALL",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:    "att2-index",
+						"projection_type": "KEYS_ONLY",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/671
 func TestAccDynamoDBTable_GsiUpdateNonKeyAttributes_emptyPlan(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -2044,6 +2116,69 @@ func TestAccDynamoDBTable_Replica_pitrKMS(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_tableClassDeletionProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf, replica1, replica2 dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaTableClassDeletionProtection(rName, "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"deletion_protection_enabled": "false",
+						"region_name":                 acctest.AlternateRegion(),
+						"table_class_override":        dynamodb.TableClassStandard,
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_replicaTableClassDeletionProtection(rName, dynamodb.TableClassStandardInfrequentAccess, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					testAccCheckReplicaExists(ctx, resourceName, acctest.AlternateRegion(), &replica1),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"deletion_protection_enabled": "true",
+						"region_name":                 acctest.AlternateRegion(),
+						"table_class_override":        dynamodb.TableClassStandardInfrequentAccess,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccTableConfig_replicaTableClassDeletionProtection(rName, dynamodb.TableClassStandard, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					testAccCheckReplicaExists(ctx, resourceName, acctest.AlternateRegion(), &replica2),
+					testAccCheckTableNotRecreated(&replica1, &replica2),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"deletion_protection_enabled": "false",
+						"region_name":                 acctest.AlternateRegion(),
+						"table_class_override":        dynamodb.TableClassStandard,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_tagsOneOfTwo(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3441,6 +3576,48 @@ resource "aws_dynamodb_table" "test" {
 `, rName, attributes)
 }
 
+func testAccTableConfig_gsiProjectionType(rName, projectionType1, projectionType2 string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "att1"
+    type = "S"
+  }
+
+  attribute {
+    name = "att2"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "att1-index"
+    hash_key        = "att1"
+    write_capacity  = 1
+    read_capacity   = 1
+    projection_type = %[2]q
+  }
+
+  global_secondary_index {
+    name            = "att2-index"
+    hash_key        = "att2"
+    write_capacity  = 1
+    read_capacity   = 1
+    projection_type = %[3]q
+  }
+}
+`, rName, projectionType1, projectionType2)
+}
+
 func testAccTableConfig_lsiNonKeyAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -3924,6 +4101,40 @@ resource "aws_dynamodb_table" "test" {
 `, rName, mainPITR, replica1, replica2))
 }
 
+func testAccTableConfig_replicaTableClassDeletionProtection(rName, tableClass string, deletionProtection bool) string {
+	tableClassOverride := ""
+	if tableClass != "" {
+		tableClassOverride = fmt.Sprintf("table_class_override = %q", tableClass)
+	}
+
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name                 = data.aws_region.alternate.name
+    deletion_protection_enabled = %[3]t
+    %[2]s
+  }
+}
+`, rName, tableClassOverride, deletionProtection))
+}
+
 func testAccTableConfig_replicaTags(rName, key, value string, propagate1, propagate2 bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
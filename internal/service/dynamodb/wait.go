@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -139,6 +141,33 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName, inde
 	return nil, err
 }
 
+// waitGSIsActive waits until the table and all of its Global Secondary Indexes are ACTIVE.
+// Only one online index can be created or deleted at a time per table.
+func waitGSIsActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, maxDuration(updateTableTimeout, timeout), func() (bool, error) {
+		table, err := FindTableByName(ctx, conn, tableName)
+
+		if err != nil {
+			return false, err
+		}
+
+		if status := aws.StringValue(table.TableStatus); status != dynamodb.TableStatusActive {
+			return false, nil
+		}
+
+		for _, v := range table.GlobalSecondaryIndexes {
+			if status := aws.StringValue(v.IndexStatus); status != dynamodb.IndexStatusActive {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		Delay:        1 * time.Second,
+		PollInterval: 5 * time.Second,
+	})
+}
+
 func waitGSIDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string, timeout time.Duration) (*dynamodb.GlobalSecondaryIndexDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{dynamodb.IndexStatusActive, dynamodb.IndexStatusDeleting, dynamodb.IndexStatusUpdating},
@@ -277,3 +306,47 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 
 	return err
 }
+
+func waitTableExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableExport(ctx, conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if status := aws.StringValue(output.ExportStatus); status == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTableImportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ImportStatusInProgress},
+		Target:  []string{dynamodb.ImportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableImport(ctx, conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if status := aws.StringValue(output.ImportStatus); status == dynamodb.ImportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -52,6 +52,32 @@ const (
 	vpcEndpointStatePendingAcceptance = "pendingAcceptance"
 )
 
+const (
+	instanceUpdateBehaviorReplace       = "replace"
+	instanceUpdateBehaviorStopAndModify = "stop_and_modify"
+)
+
+func instanceUpdateBehavior_Values() []string {
+	return []string{
+		instanceUpdateBehaviorReplace,
+		instanceUpdateBehaviorStopAndModify,
+	}
+}
+
+const (
+	instanceTypesSortByInstanceType = "instance-type"
+	instanceTypesSortByMemory       = "memory"
+	instanceTypesSortByVCPUs        = "vcpus"
+)
+
+func instanceTypesSortBy_Values() []string {
+	return []string{
+		instanceTypesSortByInstanceType,
+		instanceTypesSortByMemory,
+		instanceTypesSortByVCPUs,
+	}
+}
+
 const (
 	vpnStateModifying = "modifying"
 )
@@ -278,6 +304,18 @@ func securityGroupRuleType_Values() []string {
 	}
 }
 
+const (
+	securityGroupExternalRulesError  = "error"
+	securityGroupExternalRulesIgnore = "ignore"
+)
+
+func securityGroupExternalRules_Values() []string {
+	return []string{
+		securityGroupExternalRulesError,
+		securityGroupExternalRulesIgnore,
+	}
+}
+
 const (
 	ResInstance      = "Instance"
 	ResInstanceState = "Instance State"
@@ -287,3 +325,17 @@ const (
 	gatewayIDLocal      = "local"
 	gatewayIDVPCLattice = "VpcLattice"
 )
+
+const (
+	availabilityZoneTypeAvailabilityZone = "availability-zone"
+	availabilityZoneTypeLocalZone        = "local-zone"
+	availabilityZoneTypeWavelengthZone   = "wavelength-zone"
+)
+
+func availabilityZoneType_Values() []string {
+	return []string{
+		availabilityZoneTypeAvailabilityZone,
+		availabilityZoneTypeLocalZone,
+		availabilityZoneTypeWavelengthZone,
+	}
+}
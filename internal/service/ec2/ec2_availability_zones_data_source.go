@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 // @SDKDataSource("aws_availability_zones")
@@ -42,6 +43,7 @@ func DataSourceAvailabilityZones() *schema.Resource {
 			"filter": CustomFiltersSchema(),
 			"group_names": {
 				Type:     schema.TypeSet,
+				Optional: true,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
@@ -50,6 +52,16 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"parent_zone_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_zone_names": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +77,14 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"zone_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(availabilityZoneType_Values(), false),
+				},
+			},
 		},
 	}
 }
@@ -90,6 +110,20 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if v, ok := d.GetOk("group_names"); ok && v.(*schema.Set).Len() > 0 {
+		request.Filters = append(request.Filters, &ec2.Filter{
+			Name:   aws.String("group-name"),
+			Values: flex.ExpandStringSet(v.(*schema.Set)),
+		})
+	}
+
+	if v, ok := d.GetOk("zone_types"); ok && v.(*schema.Set).Len() > 0 {
+		request.Filters = append(request.Filters, &ec2.Filter{
+			Name:   aws.String("zone-type"),
+			Values: flex.ExpandStringSet(v.(*schema.Set)),
+		})
+	}
+
 	if filters, filtersOk := d.GetOk("filter"); filtersOk {
 		request.Filters = append(request.Filters, BuildCustomFilterList(
 			filters.(*schema.Set),
@@ -116,6 +150,8 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 
 	groupNames := schema.NewSet(schema.HashString, nil)
 	names := []string{}
+	parentZoneIDs := map[string]string{}
+	parentZoneNames := map[string]string{}
 	zoneIds := []string{}
 	for _, v := range resp.AvailabilityZones {
 		groupName := aws.StringValue(v.GroupName)
@@ -136,6 +172,14 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 
 		names = append(names, name)
 		zoneIds = append(zoneIds, zoneID)
+
+		// Local Zones and Wavelength Zones are children of an Availability Zone in the parent Region.
+		if parentZoneID := aws.StringValue(v.ParentZoneId); parentZoneID != "" {
+			parentZoneIDs[zoneID] = parentZoneID
+		}
+		if parentZoneName := aws.StringValue(v.ParentZoneName); parentZoneName != "" {
+			parentZoneNames[name] = parentZoneName
+		}
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
@@ -146,6 +190,12 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("names", names); err != nil {
 		return sdkdiag.AppendErrorf(diags, "Error setting Availability Zone names: %s", err)
 	}
+	if err := d.Set("parent_zone_ids", parentZoneIDs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parent_zone_ids: %s", err)
+	}
+	if err := d.Set("parent_zone_names", parentZoneNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parent_zone_names: %s", err)
+	}
 	if err := d.Set("zone_ids", zoneIds); err != nil {
 		return sdkdiag.AppendErrorf(diags, "Error setting Availability Zone IDs: %s", err)
 	}
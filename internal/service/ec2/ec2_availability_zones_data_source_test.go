@@ -188,6 +188,51 @@ func TestAccEC2AvailabilityZonesDataSource_stateFilter(t *testing.T) {
 	})
 }
 
+func TestAccEC2AvailabilityZonesDataSource_zoneTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_availability_zones.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZonesDataSourceConfig_zoneTypes("availability-zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAvailabilityZonesMeta(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "group_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "group_names.*", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "parent_zone_ids.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "parent_zone_names.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AvailabilityZonesDataSource_localZoneGroupNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_availability_zones.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckLocalZoneAvailable(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZonesDataSourceConfig_localZoneGroupNames(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAvailabilityZonesMeta(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "group_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.#", dataSourceName, "parent_zone_names.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_ids.#", dataSourceName, "parent_zone_ids.%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAvailabilityZonesMeta(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -358,3 +403,32 @@ data "aws_availability_zones" "state_filter" {
   state = "available"
 }
 `
+
+func testAccAvailabilityZonesDataSourceConfig_zoneTypes(zoneType string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "test" {
+  zone_types = [%[1]q]
+}
+`, zoneType)
+}
+
+func testAccAvailabilityZonesDataSourceConfig_localZoneGroupNames() string {
+	return `
+data "aws_availability_zone" "local_zone" {
+  filter {
+    name   = "zone-type"
+    values = ["local-zone"]
+  }
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opted-in"]
+  }
+}
+
+data "aws_availability_zones" "test" {
+  group_names = [data.aws_availability_zone.local_zone.group_name]
+  zone_types  = ["local-zone"]
+}
+`
+}
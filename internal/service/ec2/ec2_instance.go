@@ -299,7 +299,6 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"enclave_options": {
 				Type:     schema.TypeList,
@@ -748,6 +747,11 @@ func ResourceInstance() *schema.Resource {
 					return
 				},
 			},
+			"update_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(instanceUpdateBehavior_Values(), false),
+			},
 			"user_data_replace_on_change": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -820,6 +824,9 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customdiff.ForceNewIf("ebs_optimized", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("update_behavior").(string) != instanceUpdateBehaviorStopAndModify
+			}),
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -1536,7 +1543,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
+	if d.HasChanges("ebs_optimized", "instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
 		// Only one attribute can be modified at a time, else we get
@@ -1556,6 +1563,21 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
+		if d.HasChange("ebs_optimized") {
+			log.Printf("[INFO] Modifying EBS optimization %s", d.Id())
+
+			input := &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				EbsOptimized: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+				},
+			}
+
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) EBS optimization: %s", d.Id(), err)
+			}
+		}
+
 		// From the API reference:
 		// "If you are using an AWS SDK or command line tool,
 		// base64-encoding is performed for you, and you can load the text from a file.
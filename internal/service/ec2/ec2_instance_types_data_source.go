@@ -2,12 +2,14 @@ package ec2
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -28,6 +30,16 @@ func DataSourceInstanceTypes() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"sort_ascending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"sort_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(instanceTypesSortBy_Values(), false),
+			},
 		},
 	}
 }
@@ -48,6 +60,10 @@ func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Types: %s", err)
 	}
 
+	if v, ok := d.GetOk("sort_by"); ok {
+		sortInstanceTypes(output, v.(string), d.Get("sort_ascending").(bool))
+	}
+
 	var instanceTypes []string
 
 	for _, instanceType := range output {
@@ -59,3 +75,35 @@ func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
+
+// sortInstanceTypes sorts instance types in place by the specified key.
+// Ties are broken by instance type name so that the result is stable across reads.
+func sortInstanceTypes(instanceTypes []*ec2.InstanceTypeInfo, sortBy string, ascending bool) {
+	key := func(v *ec2.InstanceTypeInfo) int64 {
+		switch sortBy {
+		case instanceTypesSortByMemory:
+			if v.MemoryInfo != nil {
+				return aws.Int64Value(v.MemoryInfo.SizeInMiB)
+			}
+		case instanceTypesSortByVCPUs:
+			if v.VCpuInfo != nil {
+				return aws.Int64Value(v.VCpuInfo.DefaultVCpus)
+			}
+		}
+
+		return 0
+	}
+
+	sort.SliceStable(instanceTypes, func(i, j int) bool {
+		a, b := instanceTypes[i], instanceTypes[j]
+		if !ascending {
+			a, b = b, a
+		}
+
+		if ka, kb := key(a), key(b); ka != kb {
+			return ka < kb
+		}
+
+		return aws.StringValue(a.InstanceType) < aws.StringValue(b.InstanceType)
+	})
+}
@@ -2,6 +2,7 @@ package ec2_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -50,6 +51,38 @@ func TestAccEC2InstanceTypesDataSource_filter(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstanceTypesDataSource_sort(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckInstanceTypes(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesDataSourceConfig_sort("memory", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0", "t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.1", "t3.small"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.2", "t3.large"),
+				),
+			},
+			{
+				Config: testAccInstanceTypesDataSourceConfig_sort("vcpus", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0", "t3.small"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.1", "t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.2", "t3.large"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckInstanceTypes(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
@@ -82,3 +115,17 @@ data "aws_ec2_instance_types" "test" {
 }
 `
 }
+
+func testAccInstanceTypesDataSourceConfig_sort(sortBy string, sortAscending bool) string {
+	return fmt.Sprintf(`
+data "aws_ec2_instance_types" "test" {
+  filter {
+    name   = "instance-type"
+    values = ["t3.large", "t3.micro", "t3.small"]
+  }
+
+  sort_by        = %[1]q
+  sort_ascending = %[2]t
+}
+`, sortBy, sortAscending)
+}
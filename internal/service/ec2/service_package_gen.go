@@ -464,6 +464,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEBSEncryptionByDefault,
 			TypeName: "aws_ebs_encryption_by_default",
 		},
+		{
+			Factory:  ResourceEBSFastSnapshotRestore,
+			TypeName: "aws_ebs_fast_snapshot_restore",
+		},
 		{
 			Factory:  ResourceEBSSnapshot,
 			TypeName: "aws_ebs_snapshot",
@@ -536,6 +540,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClientVPNRoute,
 			TypeName: "aws_ec2_client_vpn_route",
 		},
+		{
+			Factory:  ResourceEIPTransfer,
+			TypeName: "aws_ec2_eip_transfer",
+		},
+		{
+			Factory:  ResourceEIPTransferAccepter,
+			TypeName: "aws_ec2_eip_transfer_accepter",
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_ec2_fleet",
@@ -580,6 +592,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceManagedPrefixListEntry,
 			TypeName: "aws_ec2_managed_prefix_list_entry",
 		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScope,
+			TypeName: "aws_ec2_network_insights_access_scope",
+			Name:     "Network Insights Access Scope",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScopeAnalysis,
+			TypeName: "aws_ec2_network_insights_access_scope_analysis",
+			Name:     "Network Insights Access Scope Analysis",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceNetworkInsightsAnalysis,
 			TypeName: "aws_ec2_network_insights_analysis",
@@ -660,6 +688,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+		},
 		{
 			Factory:  ResourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -1070,6 +1070,22 @@ func StatusVPNConnectionRouteState(ctx context.Context, conn *ec2.EC2, vpnConnec
 	}
 }
 
+func StatusAddressTransferStatus(ctx context.Context, conn *ec2.EC2, allocationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAddressTransferByAllocationID(ctx, conn, allocationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AddressTransferStatus), nil
+	}
+}
+
 func StatusHostState(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindHostByID(ctx, conn, id)
@@ -1118,6 +1134,22 @@ func StatusManagedPrefixListState(ctx context.Context, conn *ec2.EC2, id string)
 	}
 }
 
+func StatusNetworkInsightsAccessScopeAnalysis(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func StatusNetworkInsightsAnalysis(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindNetworkInsightsAnalysisByID(ctx, conn, id)
@@ -1301,6 +1333,22 @@ func StatusSnapshotStorageTier(ctx context.Context, conn *ec2.EC2, id string) re
 	}
 }
 
+func StatusFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, availabilityZone, snapshotID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusIPAMState(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindIPAMByID(ctx, conn, id)
@@ -0,0 +1,163 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_association")
+func ResourceTransitGatewayDefaultRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayDefaultRouteTableAssociationRead,
+		UpdateWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayDefaultRouteTableAssociationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &ec2.ModifyTransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String(d.Get("transit_gateway_route_table_id").(string)),
+			DefaultRouteTableAssociation:   aws.String(ec2.DefaultRouteTableAssociationValueEnable),
+		},
+		TransitGatewayId: aws.String(transitGatewayID),
+	}
+
+	if _, err := conn.ModifyTransitGatewayWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Default Route Table Association (%s): %s", transitGatewayID, err)
+	}
+
+	d.SetId(transitGatewayID)
+	d.Set("original_default_route_table_id", transitGateway.Options.AssociationDefaultRouteTableId)
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, d.Id())
+
+	if err == nil && aws.StringValue(transitGateway.Options.DefaultRouteTableAssociation) == ec2.DefaultRouteTableAssociationValueDisable {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Default Route Table Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("transit_gateway_id", transitGateway.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", transitGateway.Options.AssociationDefaultRouteTableId)
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &ec2.ModifyTransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String(d.Get("transit_gateway_route_table_id").(string)),
+		},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+
+	if _, err := conn.ModifyTransitGatewayWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &ec2.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(d.Id()),
+	}
+
+	// Restore the default route table the Transit Gateway had before this resource was created.
+	if v, ok := d.GetOk("original_default_route_table_id"); ok {
+		input.Options.AssociationDefaultRouteTableId = aws.String(v.(string))
+	} else {
+		input.Options.DefaultRouteTableAssociation = aws.String(ec2.DefaultRouteTableAssociationValueDisable)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Default Route Table Association: %s", d.Id())
+	_, err := conn.ModifyTransitGatewayWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Default Route Table Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Default Route Table Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
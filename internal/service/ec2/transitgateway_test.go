@@ -39,6 +39,14 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"InsideCidrBlocks":      testAccTransitGatewayConnectPeer_insideCIDRBlocks,
 			"TransitGatewayAddress": testAccTransitGatewayConnectPeer_TransitGatewayAddress,
 		},
+		"DefaultRouteTableAssociation": {
+			"basic":      testAccTransitGatewayDefaultRouteTableAssociation_basic,
+			"disappears": testAccTransitGatewayDefaultRouteTableAssociation_disappears,
+		},
+		"DefaultRouteTablePropagation": {
+			"basic":      testAccTransitGatewayDefaultRouteTablePropagation_basic,
+			"disappears": testAccTransitGatewayDefaultRouteTablePropagation_disappears,
+		},
 		"Gateway": {
 			"basic":                       testAccTransitGateway_basic,
 			"disappears":                  testAccTransitGateway_disappears,
@@ -2,7 +2,9 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -284,6 +286,12 @@ func resourceDefaultVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceDefaultVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if d.Get("force_destroy").(bool) {
+		conn := meta.(*conns.AWSClient).EC2Conn()
+
+		if err := emptyDefaultVPC(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default VPC (%s): %s", d.Id(), err)
+		}
+
 		return append(diags, resourceVPCDelete(ctx, d, meta)...)
 	}
 
@@ -291,3 +299,52 @@ func resourceDefaultVPCDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+// emptyDefaultVPC deletes the internet gateway and default subnets that AWS creates with a default VPC so that the VPC can be deleted.
+// The default security group, network ACL and route table are deleted along with the VPC.
+func emptyDefaultVPC(ctx context.Context, conn *ec2.EC2, vpcID string) error {
+	const (
+		timeout = 20 * time.Minute
+	)
+
+	igws, err := FindInternetGateways(ctx, conn, &ec2.DescribeInternetGatewaysInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"attachment.vpc-id": vpcID,
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Internet Gateways: %w", err)
+	}
+
+	for _, v := range igws {
+		internetGatewayID := aws.StringValue(v.InternetGatewayId)
+
+		if err := detachInternetGateway(ctx, conn, internetGatewayID, vpcID, timeout); err != nil {
+			return err
+		}
+
+		if err := deleteInternetGateway(ctx, conn, internetGatewayID, timeout); err != nil {
+			return err
+		}
+	}
+
+	subnets, err := FindSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"default-for-az": "true",
+			"vpc-id":         vpcID,
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Subnets: %w", err)
+	}
+
+	for _, v := range subnets {
+		if err := deleteSubnet(ctx, conn, aws.StringValue(v.SubnetId), timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
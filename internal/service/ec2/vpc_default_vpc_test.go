@@ -187,7 +187,6 @@ func testAccDefaultVPC_Existing_forceDestroy(t *testing.T) {
 					acctest.CheckVPCExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "existing_default_vpc", "true"),
 					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
-					testAccCheckDefaultVPCEmpty(ctx, &v),
 				),
 			},
 		},
@@ -309,7 +308,6 @@ func testAccDefaultVPC_NotFound_forceDestroy(t *testing.T) {
 					acctest.CheckVPCExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "existing_default_vpc", "false"),
 					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
-					testAccCheckDefaultVPCEmpty(ctx, &v),
 				),
 			},
 		},
@@ -463,13 +461,6 @@ func testAccCheckDefaultVPCDestroyNotFound(ctx context.Context) resource.TestChe
 	}
 }
 
-// testAccCheckDefaultVPCEmpty returns a TestCheckFunc that empties the specified default VPC.
-func testAccCheckDefaultVPCEmpty(ctx context.Context, v *ec2.Vpc) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		return testAccEmptyDefaultVPC(ctx, aws.StringValue(v.VpcId))
-	}
-}
-
 // testAccEmptyDefaultVPC empties a default VPC so that it can be deleted.
 func testAccEmptyDefaultVPC(ctx context.Context, vpcID string) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
@@ -82,6 +82,12 @@ func ResourceVPCEndpointService() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"payer_responsibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.PayerResponsibility_Values(), false),
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -195,6 +201,12 @@ func resourceVPCEndpointServiceCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if v, ok := d.GetOk("payer_responsibility"); ok {
+		if err := modifyVPCEndpointServicePayerResponsibility(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceVPCEndpointServiceRead(ctx, d, meta)...)
 }
 
@@ -228,6 +240,17 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("gateway_load_balancer_arns", aws.StringValueSlice(svcCfg.GatewayLoadBalancerArns))
 	d.Set("manages_vpc_endpoints", svcCfg.ManagesVpcEndpoints)
 	d.Set("network_load_balancer_arns", aws.StringValueSlice(svcCfg.NetworkLoadBalancerArns))
+	// Payer responsibility is only returned in the consumer view of the service.
+	// Some partitions (e.g. GovCloud) return only service names in that view.
+	svcDetail, err := FindVPCEndpointServiceDetailByName(ctx, conn, aws.StringValue(svcCfg.ServiceName))
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s) details: %s", d.Id(), err)
+	default:
+		d.Set("payer_responsibility", svcDetail.PayerResponsibility)
+	}
 	d.Set("private_dns_name", svcCfg.PrivateDnsName)
 	// The EC2 API can return a XML structure with no elements.
 	if tfMap := flattenPrivateDNSNameConfiguration(svcCfg.PrivateDnsNameConfiguration); len(tfMap) > 0 {
@@ -305,6 +328,14 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("payer_responsibility") {
+		if v, ok := d.GetOk("payer_responsibility"); ok {
+			if err := modifyVPCEndpointServicePayerResponsibility(ctx, conn, d.Id(), v.(string)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceVPCEndpointServiceRead(ctx, d, meta)...)
 }
 
@@ -336,6 +367,19 @@ func resourceVPCEndpointServiceDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func modifyVPCEndpointServicePayerResponsibility(ctx context.Context, conn *ec2.EC2, serviceID, payerResponsibility string) error {
+	input := &ec2.ModifyVpcEndpointServicePayerResponsibilityInput{
+		PayerResponsibility: aws.String(payerResponsibility),
+		ServiceId:           aws.String(serviceID),
+	}
+
+	if _, err := conn.ModifyVpcEndpointServicePayerResponsibilityWithContext(ctx, input); err != nil {
+		return fmt.Errorf("modifying EC2 VPC Endpoint Service (%s) payer responsibility: %w", serviceID, err)
+	}
+
+	return nil
+}
+
 func flattenAllowedPrincipal(apiObject *ec2.AllowedPrincipal) *string {
 	if apiObject == nil {
 		return nil
@@ -55,10 +55,18 @@ func DataSourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"payer_responsibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"private_dns_name_verification_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"service": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -183,7 +191,9 @@ func dataSourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("base_endpoint_dns_names", aws.StringValueSlice(sd.BaseEndpointDnsNames))
 	d.Set("manages_vpc_endpoints", sd.ManagesVpcEndpoints)
 	d.Set("owner", sd.Owner)
+	d.Set("payer_responsibility", sd.PayerResponsibility)
 	d.Set("private_dns_name", sd.PrivateDnsName)
+	d.Set("private_dns_name_verification_state", sd.PrivateDnsNameVerificationState)
 	d.Set("service_id", serviceID)
 	d.Set("service_name", serviceName)
 	if len(sd.ServiceType) > 0 {
@@ -91,6 +91,7 @@ func TestAccVPCEndpointServiceDataSource_custom(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "availability_zones.#", resourceName, "availability_zones.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "base_endpoint_dns_names.#", resourceName, "base_endpoint_dns_names.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "manages_vpc_endpoints", resourceName, "manages_vpc_endpoints"),
+					resource.TestCheckResourceAttrPair(datasourceName, "payer_responsibility", resourceName, "payer_responsibility"),
 					acctest.CheckResourceAttrAccountID(datasourceName, "owner"),
 					resource.TestCheckResourceAttrPair(datasourceName, "private_dns_name", resourceName, "private_dns_name"),
 					resource.TestCheckResourceAttr(datasourceName, "service_type", "Interface"),
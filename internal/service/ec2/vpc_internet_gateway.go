@@ -163,24 +163,32 @@ func resourceInternetGatewayDelete(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if err := deleteInternetGateway(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func deleteInternetGateway(ctx context.Context, conn *ec2.EC2, internetGatewayID string, timeout time.Duration) error {
 	input := &ec2.DeleteInternetGatewayInput{
-		InternetGatewayId: aws.String(d.Id()),
+		InternetGatewayId: aws.String(internetGatewayID),
 	}
 
-	log.Printf("[INFO] Deleting Internet Gateway: %s", d.Id())
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	log.Printf("[INFO] Deleting Internet Gateway: %s", internetGatewayID)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DeleteInternetGatewayWithContext(ctx, input)
 	}, errCodeDependencyViolation)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidInternetGatewayIDNotFound) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting EC2 Internet Gateway (%s): %s", d.Id(), err)
+		return fmt.Errorf("deleting EC2 Internet Gateway (%s): %w", internetGatewayID, err)
 	}
 
-	return diags
+	return nil
 }
 
 func attachInternetGateway(ctx context.Context, conn *ec2.EC2, internetGatewayID, vpcID string, timeout time.Duration) error {
@@ -41,11 +41,12 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsCIDRNetwork(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDRNetwork(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+				ConflictsWith: []string{"ipv4_netmask_length"},
 			},
 			"ipv4_ipam_pool_id": {
 				Type:     schema.TypeString,
@@ -53,10 +54,12 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"ipv4_netmask_length": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IntBetween(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
+				ConflictsWith: []string{"cidr_block"},
+				RequiredWith:  []string{"ipv4_ipam_pool_id"},
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
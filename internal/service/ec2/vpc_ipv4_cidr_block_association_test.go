@@ -3,6 +3,7 @@ package ec2_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -99,8 +100,15 @@ func TestAccVPCIPv4CIDRBlockAssociation_ipamBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx, "aws_vpc_ipv4_cidr_block_association.secondary_cidr", &associationSecondary),
 					testAccCheckVPCAssociationCIDRPrefix(&associationSecondary, "28"),
+					resource.TestCheckResourceAttrSet("aws_vpc_ipv4_cidr_block_association.secondary_cidr", "cidr_block"),
 				),
 			},
+			{
+				ResourceName:            "aws_vpc_ipv4_cidr_block_association.secondary_cidr",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_ipam_pool_id", "ipv4_netmask_length"},
+			},
 		},
 	})
 }
@@ -131,6 +139,27 @@ func TestAccVPCIPv4CIDRBlockAssociation_ipamBasicExplicitCIDR(t *testing.T) {
 	})
 }
 
+func TestAccVPCIPv4CIDRBlockAssociation_ipamValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCIPv4CIDRBlockAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCIPv4CIDRBlockAssociationConfig_ipamCIDRAndNetmaskLength(),
+				ExpectError: regexp.MustCompile(`"ipv4_netmask_length": conflicts with cidr_block`),
+			},
+			{
+				Config:      testAccVPCIPv4CIDRBlockAssociationConfig_netmaskLengthNoPool(),
+				ExpectError: regexp.MustCompile(`all of .ipv4_ipam_pool_id,ipv4_netmask_length. must be specified`),
+			},
+		},
+	})
+}
+
 func testAccCheckAdditionalVPCIPv4CIDRBlock(association *ec2.VpcCidrBlockAssociation, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		CIDRBlock := association.CidrBlock
@@ -264,3 +293,23 @@ resource "aws_vpc_ipv4_cidr_block_association" "secondary_cidr" {
 }
 `, rName, cidr))
 }
+
+func testAccVPCIPv4CIDRBlockAssociationConfig_ipamCIDRAndNetmaskLength() string {
+	return `
+resource "aws_vpc_ipv4_cidr_block_association" "test" {
+  cidr_block          = "172.2.0.32/28"
+  ipv4_ipam_pool_id   = "ipam-pool-0123456789abcdef0"
+  ipv4_netmask_length = 28
+  vpc_id              = "vpc-0123456789abcdef0"
+}
+`
+}
+
+func testAccVPCIPv4CIDRBlockAssociationConfig_netmaskLengthNoPool() string {
+	return `
+resource "aws_vpc_ipv4_cidr_block_association" "test" {
+  ipv4_netmask_length = 28
+  vpc_id              = "vpc-0123456789abcdef0"
+}
+`
+}
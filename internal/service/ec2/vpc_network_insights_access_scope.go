@@ -0,0 +1,484 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_network_insights_access_scope", name="Network Insights Access Scope")
+// @Tags(identifierAttribute="id")
+func ResourceNetworkInsightsAccessScope() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInsightsAccessScopeCreate,
+		ReadWithoutTimeout:   resourceNetworkInsightsAccessScopeRead,
+		UpdateWithoutTimeout: resourceNetworkInsightsAccessScopeUpdate,
+		DeleteWithoutTimeout: resourceNetworkInsightsAccessScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_paths":   accessScopePathSchema(),
+			"match_paths":     accessScopePathSchema(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func accessScopePathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": pathStatementSchema(),
+				"source":      pathStatementSchema(),
+				"through_resources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_statement": resourceStatementSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pathStatementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"packet_header_statement": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"destination_addresses":    forceNewStringSetSchema(),
+							"destination_ports":        forceNewStringSetSchema(),
+							"destination_prefix_lists": forceNewStringSetSchema(),
+							"protocols":                forceNewStringSetSchema(),
+							"source_addresses":         forceNewStringSetSchema(),
+							"source_ports":             forceNewStringSetSchema(),
+							"source_prefix_lists":      forceNewStringSetSchema(),
+						},
+					},
+				},
+				"resource_statement": resourceStatementSchema(),
+			},
+		},
+	}
+}
+
+func resourceStatementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_types": forceNewStringSetSchema(),
+				"resources":      forceNewStringSetSchema(),
+			},
+		},
+	}
+}
+
+func forceNewStringSetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func resourceNetworkInsightsAccessScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.CreateNetworkInsightsAccessScopeInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeNetworkInsightsAccessScope),
+	}
+
+	if v, ok := d.GetOk("exclude_paths"); ok && len(v.([]interface{})) > 0 {
+		input.ExcludePaths = expandAccessScopePathRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("match_paths"); ok && len(v.([]interface{})) > 0 {
+		input.MatchPaths = expandAccessScopePathRequests(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Access Scope: %s", input)
+	output, err := conn.CreateNetworkInsightsAccessScopeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EC2 Network Insights Access Scope: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAccessScope.NetworkInsightsAccessScopeId))
+
+	return resourceNetworkInsightsAccessScopeRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	scope, err := FindNetworkInsightsAccessScopeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Access Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Network Insights Access Scope (%s): %s", d.Id(), err)
+	}
+
+	content, err := FindNetworkInsightsAccessScopeContentByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Network Insights Access Scope (%s) content: %s", d.Id(), err)
+	}
+
+	d.Set("arn", scope.NetworkInsightsAccessScopeArn)
+	if err := d.Set("exclude_paths", flattenAccessScopePaths(content.ExcludePaths)); err != nil {
+		return diag.Errorf("setting exclude_paths: %s", err)
+	}
+	if err := d.Set("match_paths", flattenAccessScopePaths(content.MatchPaths)); err != nil {
+		return diag.Errorf("setting match_paths: %s", err)
+	}
+
+	SetTagsOut(ctx, scope.Tags)
+
+	return nil
+}
+
+func resourceNetworkInsightsAccessScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceNetworkInsightsAccessScopeRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Access Scope: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAccessScopeWithContext(ctx, &ec2.DeleteNetworkInsightsAccessScopeInput{
+		NetworkInsightsAccessScopeId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EC2 Network Insights Access Scope (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAccessScopePathRequest(tfMap map[string]interface{}) *ec2.AccessScopePathRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.AccessScopePathRequest{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Destination = expandPathStatementRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Source = expandPathStatementRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["through_resources"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ThroughResources = expandThroughResourcesStatementRequests(v)
+	}
+
+	return apiObject
+}
+
+func expandAccessScopePathRequests(tfList []interface{}) []*ec2.AccessScopePathRequest {
+	var apiObjects []*ec2.AccessScopePathRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAccessScopePathRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandPathStatementRequest(tfMap map[string]interface{}) *ec2.PathStatementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.PathStatementRequest{}
+
+	if v, ok := tfMap["packet_header_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PacketHeaderStatement = expandPacketHeaderStatementRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["resource_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ResourceStatement = expandResourceStatementRequest(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPacketHeaderStatementRequest(tfMap map[string]interface{}) *ec2.PacketHeaderStatementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.PacketHeaderStatementRequest{}
+
+	if v, ok := tfMap["destination_addresses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DestinationAddresses = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["destination_ports"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DestinationPorts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["destination_prefix_lists"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DestinationPrefixLists = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Protocols = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["source_addresses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourceAddresses = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["source_ports"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourcePorts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["source_prefix_lists"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourcePrefixLists = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandResourceStatementRequest(tfMap map[string]interface{}) *ec2.ResourceStatementRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ResourceStatementRequest{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Resources = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandThroughResourcesStatementRequests(tfList []interface{}) []*ec2.ThroughResourcesStatementRequest {
+	var apiObjects []*ec2.ThroughResourcesStatementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ec2.ThroughResourcesStatementRequest{}
+
+		if v, ok := tfMap["resource_statement"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResourceStatement = expandResourceStatementRequest(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAccessScopePath(apiObject *ec2.AccessScopePath) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = []interface{}{flattenPathStatement(v)}
+	}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = []interface{}{flattenPathStatement(v)}
+	}
+
+	if v := apiObject.ThroughResources; v != nil {
+		tfMap["through_resources"] = flattenThroughResourcesStatements(v)
+	}
+
+	return tfMap
+}
+
+func flattenAccessScopePaths(apiObjects []*ec2.AccessScopePath) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAccessScopePath(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenPathStatement(apiObject *ec2.PathStatement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PacketHeaderStatement; v != nil {
+		tfMap["packet_header_statement"] = []interface{}{flattenPacketHeaderStatement(v)}
+	}
+
+	if v := apiObject.ResourceStatement; v != nil {
+		tfMap["resource_statement"] = []interface{}{flattenResourceStatement(v)}
+	}
+
+	return tfMap
+}
+
+func flattenPacketHeaderStatement(apiObject *ec2.PacketHeaderStatement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationAddresses; v != nil {
+		tfMap["destination_addresses"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.DestinationPorts; v != nil {
+		tfMap["destination_ports"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.DestinationPrefixLists; v != nil {
+		tfMap["destination_prefix_lists"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Protocols; v != nil {
+		tfMap["protocols"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SourceAddresses; v != nil {
+		tfMap["source_addresses"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SourcePorts; v != nil {
+		tfMap["source_ports"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SourcePrefixLists; v != nil {
+		tfMap["source_prefix_lists"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenResourceStatement(apiObject *ec2.ResourceStatement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceTypes; v != nil {
+		tfMap["resource_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Resources; v != nil {
+		tfMap["resources"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenThroughResourcesStatements(apiObjects []*ec2.ThroughResourcesStatement) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ResourceStatement; v != nil {
+			tfMap["resource_statement"] = []interface{}{flattenResourceStatement(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,193 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_network_insights_access_scope_analysis", name="Network Insights Access Scope Analysis")
+// @Tags(identifierAttribute="id")
+func ResourceNetworkInsightsAccessScopeAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisCreate,
+		ReadWithoutTimeout:   resourceNetworkInsightsAccessScopeAnalysisRead,
+		UpdateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisUpdate,
+		DeleteWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzed_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fail_if_findings_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"findings_found": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_insights_access_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("fail_if_findings_found").(bool) && !d.Get("wait_for_completion").(bool) {
+					return errors.New(`"fail_if_findings_found" requires "wait_for_completion" to be true`)
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.StartNetworkInsightsAccessScopeAnalysisInput{
+		ClientToken:                  aws.String(id.UniqueId()),
+		NetworkInsightsAccessScopeId: aws.String(d.Get("network_insights_access_scope_id").(string)),
+		TagSpecifications:            getTagSpecificationsIn(ctx, ec2.ResourceTypeNetworkInsightsAccessScopeAnalysis),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Access Scope Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAccessScopeAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EC2 Network Insights Access Scope Analysis: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAccessScopeAnalysis.NetworkInsightsAccessScopeAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		output, err := WaitNetworkInsightsAccessScopeAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EC2 Network Insights Access Scope Analysis (%s) create: %s", d.Id(), err)
+		}
+
+		// Fail the apply, tainting the analysis so that it is run again on the next apply.
+		if d.Get("fail_if_findings_found").(bool) && aws.StringValue(output.FindingsFound) != ec2.FindingsFoundFalse {
+			diags := resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)
+
+			return append(diags, diag.Errorf("EC2 Network Insights Access Scope Analysis (%s): findings found (%s) for EC2 Network Insights Access Scope (%s)", d.Id(), aws.StringValue(output.FindingsFound), d.Get("network_insights_access_scope_id").(string))...)
+		}
+	}
+
+	return resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	output, err := FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Access Scope Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	d.Set("analyzed_eni_count", output.AnalyzedEniCount)
+	d.Set("arn", output.NetworkInsightsAccessScopeAnalysisArn)
+	if output.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(output.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("findings_found", output.FindingsFound)
+	d.Set("network_insights_access_scope_id", output.NetworkInsightsAccessScopeId)
+	d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Access Scope Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAccessScopeAnalysisWithContext(ctx, &ec2.DeleteNetworkInsightsAccessScopeAnalysisInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-access-scope-analysis/.+$`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "end_date"),
+					resource.TestCheckResourceAttr(resourceName, "fail_if_findings_found", "false"),
+					resource.TestCheckResourceAttr(resourceName, "findings_found", ec2.FindingsFoundFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_access_scope_id", "aws_ec2_network_insights_access_scope.test", "id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AnalysisStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fail_if_findings_found", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInsightsAccessScopeAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_failIfFindingsFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_failIfFindingsFound(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fail_if_findings_found", "true"),
+					resource.TestCheckResourceAttr(resourceName, "findings_found", ec2.FindingsFoundFalse),
+				),
+			},
+			{
+				Config:      testAccVPCNetworkInsightsAccessScopeAnalysisConfig_failIfFindingsFound(rName, true),
+				ExpectError: regexp.MustCompile(`findings found`),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Access Scope Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_network_insights_access_scope_analysis" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Insights Access Scope Analysis %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.0.1.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_access_scope" "test" {
+  # Any path from the VPC out through an internet gateway.
+  match_paths {
+    source {
+      resource_statement {
+        resources = [aws_vpc.test.id]
+      }
+    }
+
+    through_resources {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeAnalysisConfig_base(rName), `
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+
+  depends_on = [aws_network_interface.test]
+}
+`)
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_failIfFindingsFound(rName string, internetAccess bool) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  count = %[2]t ? 1 : 0
+
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_eip" "test" {
+  count = %[2]t ? 1 : 0
+
+  domain            = "vpc"
+  network_interface = aws_network_interface.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  dynamic "route" {
+    for_each = aws_internet_gateway.test
+
+    content {
+      cidr_block = "0.0.0.0/0"
+      gateway_id = route.value.id
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  subnet_id      = aws_subnet.test.id
+  route_table_id = aws_route_table.test.id
+}
+
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+  fail_if_findings_found           = true
+
+  depends_on = [aws_eip.test, aws_network_interface.test, aws_route_table_association.test]
+
+  # Re-run the analysis whenever the routing changes.
+  lifecycle {
+    replace_triggered_by = [aws_route_table.test]
+  }
+}
+`, rName, internetAccess))
+}
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -53,6 +55,11 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 				Computed: true,
 			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
+			"fail_if_path_not_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"filter_in_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -98,7 +105,16 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("fail_if_path_not_found").(bool) && !d.Get("wait_for_completion").(bool) {
+					return errors.New(`"fail_if_path_not_found" requires "wait_for_completion" to be true`)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -1422,9 +1438,18 @@ func resourceNetworkInsightsAnalysisCreate(ctx context.Context, d *schema.Resour
 	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
 
 	if d.Get("wait_for_completion").(bool) {
-		if _, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		output, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
 			return diag.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %s", d.Id(), err)
 		}
+
+		// Fail the apply, tainting the analysis so that it is run again on the next apply.
+		if d.Get("fail_if_path_not_found").(bool) && !aws.BoolValue(output.NetworkPathFound) {
+			diags := resourceNetworkInsightsAnalysisRead(ctx, d, meta)
+
+			return append(diags, diag.Errorf("EC2 Network Insights Analysis (%s): network path not found for EC2 Network Insights Path (%s)", d.Id(), d.Get("network_insights_path_id").(string))...)
+		}
 	}
 
 	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
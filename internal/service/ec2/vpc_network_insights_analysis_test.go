@@ -191,6 +191,57 @@ func TestAccVPCNetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysis_failIfPathNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_failIfPathNotFound(rName, 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fail_if_path_not_found", "true"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fail_if_path_not_found", "wait_for_completion"},
+			},
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_failIfPathNotFound(rName, 22),
+				ExpectError: regexp.MustCompile(`network path not found`),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAnalysis_failIfPathNotFoundWithoutWait(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_failIfPathNotFoundWithoutWait(rName),
+				ExpectError: regexp.MustCompile(`"fail_if_path_not_found" requires "wait_for_completion" to be true`),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkInsightsAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -322,3 +373,65 @@ resource "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName, waitForCompletion))
 }
+
+func testAccVPCNetworkInsightsAnalysisConfig_failIfPathNotFound(rName string, destinationPort int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "source" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "destination" {
+  subnet_id       = aws_subnet.test[0].id
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_path" "test" {
+  source           = aws_network_interface.source.id
+  destination      = aws_network_interface.destination.id
+  destination_port = %[2]d
+  protocol         = "tcp"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  fail_if_path_not_found   = true
+}
+`, rName, destinationPort))
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_failIfPathNotFoundWithoutWait(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), `
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  fail_if_path_not_found   = true
+  wait_for_completion      = false
+}
+`)
+}
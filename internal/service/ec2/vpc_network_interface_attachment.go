@@ -16,6 +16,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceAttachmentCreate,
 		ReadWithoutTimeout:   resourceNetworkInterfaceAttachmentRead,
+		UpdateWithoutTimeout: resourceNetworkInterfaceAttachmentUpdate,
 		DeleteWithoutTimeout: resourceNetworkInterfaceAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -29,12 +30,10 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 			"device_index": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"network_interface_id": {
 				Type:     schema.TypeString,
@@ -96,6 +95,36 @@ func resourceNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceNetworkInterfaceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChanges("device_index", "instance_id") {
+		networkInterfaceID := d.Get("network_interface_id").(string)
+
+		// The attachment's device index and instance can't be modified, so the
+		// network interface is detached and reattached, keeping its private IPs.
+		if err := DetachNetworkInterface(ctx, conn, networkInterfaceID, d.Id(), NetworkInterfaceDetachedTimeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		attachmentID, err := attachNetworkInterface(ctx, conn,
+			networkInterfaceID,
+			d.Get("instance_id").(string),
+			d.Get("device_index").(int),
+			networkInterfaceAttachedTimeout,
+		)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.SetId(attachmentID)
+	}
+
+	return append(diags, resourceNetworkInterfaceAttachmentRead(ctx, d, meta)...)
+}
+
 func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
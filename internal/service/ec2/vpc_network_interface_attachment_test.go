@@ -42,7 +42,61 @@ func TestAccVPCNetworkInterfaceAttachment_basic(t *testing.T) {
 	})
 }
 
-func testAccVPCNetworkInterfaceAttachmentConfig_basic(rName string) string {
+func TestAccVPCNetworkInterfaceAttachment_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf ec2.NetworkInterface
+	resourceName := "aws_network_interface_attachment.test"
+	eniResourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_update(rName, "test", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, eniResourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "device_index", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AttachmentStatusAttached),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_update(rName, "test", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, eniResourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "device_index", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AttachmentStatusAttached),
+					resource.TestCheckResourceAttr(eniResourceName, "private_ip", "172.16.10.100"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceAttachmentConfig_update(rName, "test2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, eniResourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "device_index", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test2", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", eniResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AttachmentStatusAttached),
+					resource.TestCheckResourceAttr(eniResourceName, "private_ip", "172.16.10.100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_base(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
 		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
@@ -98,10 +152,35 @@ resource "aws_instance" "test" {
   }
 }
 
+`, rName))
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceAttachmentConfig_base(rName), `
 resource "aws_network_interface_attachment" "test" {
   device_index         = 1
   instance_id          = aws_instance.test.id
   network_interface_id = aws_network_interface.test.id
 }
-`, rName))
+`)
+}
+
+func testAccVPCNetworkInterfaceAttachmentConfig_update(rName, instanceResourceName string, deviceIndex int) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_instance" "test2" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface_attachment" "test" {
+  device_index         = %[3]d
+  instance_id          = aws_instance.%[2]s.id
+  network_interface_id = aws_network_interface.test.id
+}
+`, rName, instanceResourceName, deviceIndex))
 }
@@ -343,7 +343,7 @@ func TestAccVPCNetworkInterface_attachment(t *testing.T) {
 		CheckDestroy:             testAccCheckENIDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccVPCNetworkInterfaceConfig_attachment(rName),
+				Config: testAccVPCNetworkInterfaceConfig_attachment(rName, 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckENIExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "attachment.#", "1"),
@@ -361,6 +361,17 @@ func TestAccVPCNetworkInterface_attachment(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"private_ip_list_enabled", "ipv6_address_list_enabled"},
 			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_attachment(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attachment.*", map[string]string{
+						"device_index": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "private_ip", "172.16.10.100"),
+				),
+			},
 		},
 	})
 }
@@ -1287,7 +1298,7 @@ resource "aws_network_interface" "test" {
 `, rName, sourceDestCheck))
 }
 
-func testAccVPCNetworkInterfaceConfig_attachment(rName string) string {
+func testAccVPCNetworkInterfaceConfig_attachment(rName string, deviceIndex int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
 		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
@@ -1322,14 +1333,14 @@ resource "aws_network_interface" "test" {
 
   attachment {
     instance     = aws_instance.test.id
-    device_index = 1
+    device_index = %[2]d
   }
 
   tags = {
     Name = %[1]q
   }
 }
-`, rName))
+`, rName, deviceIndex))
 }
 
 func testAccVPCNetworkInterfaceConfig_externalAttachment(rName string) string {
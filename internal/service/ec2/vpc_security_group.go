@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,7 +64,12 @@ func ResourceSecurityGroup() *schema.Resource {
 				Default:      "Managed by Terraform",
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
-			"egress":  securityGroupRuleSetNestedBlock,
+			"egress": securityGroupRuleSetNestedBlock,
+			"external_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(securityGroupExternalRules_Values(), false),
+			},
 			"ingress": securityGroupRuleSetNestedBlock,
 			"name": {
 				Type:          schema.TypeString,
@@ -106,8 +112,48 @@ func ResourceSecurityGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffSecurityGroupExternalRules,
+		),
+	}
+}
+
+// customizeDiffSecurityGroupExternalRules reports rules that were added to the security group
+// outside of this resource when external_rules is "error".
+func customizeDiffSecurityGroupExternalRules(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("external_rules").(string) != securityGroupExternalRulesError {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	sg, err := FindSecurityGroupByID(ctx, conn, diff.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Security Group (%s): %w", diff.Id(), err)
 	}
+
+	remoteIngressRules := SecurityGroupIPPermGather(diff.Id(), sg.IpPermissions, sg.OwnerId)
+	remoteEgressRules := SecurityGroupIPPermGather(diff.Id(), sg.IpPermissionsEgress, sg.OwnerId)
+
+	// Match against the prior state rather than the configuration so that
+	// rules being removed from the configuration are not reported.
+	localIngressRules, _ := diff.GetChange("ingress")
+	localEgressRules, _ := diff.GetChange("egress")
+
+	_, externalIngressRules := MatchRules("ingress", localIngressRules.(*schema.Set).List(), remoteIngressRules)
+	_, externalEgressRules := MatchRules("egress", localEgressRules.(*schema.Set).List(), remoteEgressRules)
+
+	if n, m := len(externalIngressRules), len(externalEgressRules); n > 0 || m > 0 {
+		return fmt.Errorf("reading Security Group (%s): found rules not managed by this resource (%d ingress, %d egress)", diff.Id(), n, m)
+	}
+
+	return nil
 }
 
 // Security Group rule nested block definition.
@@ -279,8 +325,24 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 
 	// Loop through the local state of rules, doing a match against the remote
 	// ruleSet we built above.
-	ingressRules := MatchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := MatchRules("egress", localEgressRules, remoteEgressRules)
+	ingressRules, externalIngressRules := MatchRules("ingress", localIngressRules, remoteIngressRules)
+	egressRules, externalEgressRules := MatchRules("egress", localEgressRules, remoteEgressRules)
+
+	// Rules added outside of this resource (e.g. by EKS or load balancer controllers)
+	// are by default saved to state so that the next apply revokes them.
+	// aws_default_security_group has no external_rules argument.
+	externalRules, _ := d.Get("external_rules").(string)
+
+	switch externalRules {
+	case securityGroupExternalRulesError, securityGroupExternalRulesIgnore:
+		// Reported at plan time by customizeDiffSecurityGroupExternalRules.
+		if n, m := len(externalIngressRules), len(externalEgressRules); n > 0 || m > 0 {
+			log.Printf("[DEBUG] Security Group (%s) not saving rules not managed by this resource: %d ingress, %d egress", d.Id(), n, m)
+		}
+	default:
+		ingressRules = append(ingressRules, externalIngressRules...)
+		egressRules = append(egressRules, externalEgressRules...)
+	}
 
 	ownerID := aws.StringValue(sg.OwnerId)
 	arn := arn.ARN{
@@ -938,12 +1000,13 @@ func FlattenSecurityGroups(list []*ec2.UserIdGroupPair, ownerId *string) []*Grou
 // remote rule, which may be structured differently because of how AWS
 // aggregates the rules under the to, from, and type.
 //
-// Matching rules are written to state, with their elements removed from the
-// remote set
+// Matching rules are returned first, with their elements removed from the
+// remote set.
 //
-// If no match is found, we'll write the remote rule to state and let the graph
-// sort things out
-func MatchRules(rType string, local []interface{}, remote []map[string]interface{}) []map[string]interface{} {
+// Remote rules, or parts of rules, that have no local match are returned
+// second. These are rules added outside of Terraform; by default they are
+// written to state and we let the graph sort things out.
+func MatchRules(rType string, local []interface{}, remote []map[string]interface{}) ([]map[string]interface{}, []map[string]interface{}) {
 	// For each local ip or security_group, we need to match against the remote
 	// ruleSet until all ips or security_groups are found
 
@@ -1224,6 +1287,7 @@ func MatchRules(rType string, local []interface{}, remote []map[string]interface
 	// cidrs, and security groups. We'll add remote rules here that have not been
 	// matched locally, and let the graph sort things out. This will happen when
 	// rules are added externally to Terraform
+	var external []map[string]interface{}
 	for _, r := range remote {
 		var lenCidr, lenIpv6Cidr, lenPrefixLists, lenSGs int
 		if rCidrs, ok := r["cidr_blocks"]; ok {
@@ -1247,11 +1311,11 @@ func MatchRules(rType string, local []interface{}, remote []map[string]interface
 
 		if lenSGs+lenCidr+lenIpv6Cidr+lenPrefixLists > 0 {
 			log.Printf("[DEBUG] Found a remote Rule that wasn't empty: (%#v)", r)
-			saves = append(saves, r)
+			external = append(external, r)
 		}
 	}
 
-	return saves
+	return saves, external
 }
 
 // Duplicate ingress/egress block structure and fill out all
@@ -589,7 +589,8 @@ func TestRulesMixedMatching(t *testing.T) {
 		},
 	}
 	for i, c := range cases {
-		saves := tfec2.MatchRules("ingress", c.local, c.remote)
+		saves, external := tfec2.MatchRules("ingress", c.local, c.remote)
+		saves = append(saves, external...)
 		log.Printf("\n======\n\nSaves:\n%#v\n\nCS Saves:\n%#v\n\n======\n", saves, c.saves)
 		log.Printf("\n\tTest %d:\n", i)
 
@@ -711,3 +712,48 @@ func TestRulesMixedMatching(t *testing.T) {
 		}
 	}
 }
+
+func TestRulesExternalMatching(t *testing.T) {
+	t.Parallel()
+
+	local := []interface{}{
+		map[string]interface{}{
+			"from_port":   80,
+			"to_port":     8000,
+			"protocol":    "tcp",
+			"cidr_blocks": []interface{}{"172.8.0.0/16"},
+		},
+	}
+	remote := []map[string]interface{}{
+		{
+			"from_port":   int64(80),
+			"to_port":     int64(8000),
+			"protocol":    "tcp",
+			"cidr_blocks": []string{"172.8.0.0/16", "10.0.0.0/16"},
+		},
+		{
+			"from_port":       int64(443),
+			"to_port":         int64(443),
+			"protocol":        "tcp",
+			"security_groups": schema.NewSet(schema.HashString, []interface{}{"sg-9876"}),
+		},
+	}
+
+	saves, external := tfec2.MatchRules("ingress", local, remote)
+
+	if got, want := len(saves), 1; got != want {
+		t.Fatalf("saves: got %d, expected %d", got, want)
+	}
+
+	if got, want := len(external), 2; got != want {
+		t.Fatalf("external: got %d, expected %d", got, want)
+	}
+
+	if got, want := external[0]["cidr_blocks"].([]string), []string{"10.0.0.0/16"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("external cidr_blocks: got %v, expected %v", got, want)
+	}
+
+	if got, want := external[1]["from_port"].(int64), int64(443); got != want {
+		t.Errorf("external from_port: got %d, expected %d", got, want)
+	}
+}
@@ -908,6 +908,39 @@ func TestAccVPCSecurityGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_externalRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_externalRules(rName, "ignore"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "external_rules", "ignore"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "0"),
+				),
+			},
+			{
+				Config:             testAccVPCSecurityGroupConfig_externalRules(rName, "ignore"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config:      testAccVPCSecurityGroupConfig_externalRules(rName, "error"),
+				ExpectError: regexp.MustCompile(`found rules not managed by this resource \(1 ingress, 0 egress\)`),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group ec2.SecurityGroup
@@ -2936,6 +2969,33 @@ resource "aws_security_group" "test" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupConfig_externalRules(rName, externalRules string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name           = %[1]q
+  vpc_id         = aws_vpc.test.id
+  external_rules = %[2]q
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 80
+}
+`, rName, externalRules)
+}
+
 func testAccVPCSecurityGroupConfig_nameGenerated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
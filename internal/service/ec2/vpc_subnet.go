@@ -360,27 +360,35 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	log.Printf("[INFO] Deleting EC2 Subnet: %s", d.Id())
+	if err := deleteSubnet(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
 
-	if err := deleteLingeringENIs(ctx, conn, "subnet-id", d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting ENIs for EC2 Subnet (%s): %s", d.Id(), err)
+func deleteSubnet(ctx context.Context, conn *ec2.EC2, subnetID string, timeout time.Duration) error {
+	log.Printf("[INFO] Deleting EC2 Subnet: %s", subnetID)
+
+	if err := deleteLingeringENIs(ctx, conn, "subnet-id", subnetID, timeout); err != nil {
+		return fmt.Errorf("deleting ENIs for EC2 Subnet (%s): %w", subnetID, err)
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{
-			SubnetId: aws.String(d.Id()),
+			SubnetId: aws.String(subnetID),
 		})
 	}, errCodeDependencyViolation)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidSubnetIDNotFound) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting EC2 Subnet (%s): %s", d.Id(), err)
+		return fmt.Errorf("deleting EC2 Subnet (%s): %w", subnetID, err)
 	}
 
-	return diags
+	return nil
 }
 
 // modifySubnetAttributesOnCreate sets subnet attributes on resource Create.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -178,13 +179,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// CreateCluster will create the ECS IAM Service Linked Role on first ECS provision
 	// This process does not complete before the initial API call finishes.
-	output, err := retryClusterCreate(ctx, conn, input)
+	output, err := retryClusterCreate(ctx, conn, input, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout))
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
 		input.Tags = nil
 
-		output, err = retryClusterCreate(ctx, conn, input)
+		output, err = retryClusterCreate(ctx, conn, input, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout))
 	}
 
 	if err != nil {
@@ -419,28 +420,19 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
-func retryClusterCreate(ctx context.Context, conn *ecs.ECS, input *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error) {
-	var output *ecs.CreateClusterOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
-		var err error
-		output, err = conn.CreateClusterWithContext(ctx, input)
-
-		if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "Unable to assume the service linked role") {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
+func retryClusterCreate(ctx context.Context, conn *ecs.ECS, input *ecs.CreateClusterInput, timeout time.Duration) (*ecs.CreateClusterOutput, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.CreateClusterWithContext(ctx, input)
+		},
+		ecs.ErrCodeInvalidParameterException, "Unable to assume the service linked role",
+	)
 
-	if tfresource.TimedOut(err) {
-		output, err = conn.CreateClusterWithContext(ctx, input)
+	if err != nil {
+		return nil, err
 	}
 
-	return output, err
+	return outputRaw.(*ecs.CreateClusterOutput), nil
 }
 
 func expandClusterSettings(configured *schema.Set) []*ecs.ClusterSetting {
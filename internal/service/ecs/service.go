@@ -598,13 +598,13 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TaskDefinition = aws.String(v.(string))
 	}
 
-	output, err := serviceCreateWithRetry(ctx, conn, input)
+	output, err := serviceCreateWithRetry(ctx, conn, input, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout))
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
 		input.Tags = nil
 
-		output, err = serviceCreateWithRetry(ctx, conn, input)
+		output, err = serviceCreateWithRetry(ctx, conn, input, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout))
 	}
 
 	if err != nil {
@@ -905,26 +905,14 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Retry due to IAM eventual consistency
-		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
-			_, err := conn.UpdateServiceWithContext(ctx, input)
-
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
-					return retry.RetryableError(err)
-				}
-
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
-					return retry.RetryableError(err)
-				}
-
-				return retry.NonRetryableError(err)
-			}
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateServiceWithContext(ctx, input)
-		}
+		_, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout)+serviceUpdateTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateServiceWithContext(ctx, input)
+			},
+			ecs.ErrCodeInvalidParameterException,
+			"verify that the ECS service role being passed has the proper permissions",
+			"does not have an associated load balancer",
+		)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
@@ -1502,9 +1490,9 @@ func resourceLoadBalancerHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
-func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
+func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.CreateServiceInput, timeout time.Duration) (*ecs.CreateServiceOutput, error) {
 	var output *ecs.CreateServiceOutput
-	err := retry.RetryContext(ctx, propagationTimeout+serviceCreateTimeout, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, timeout+serviceCreateTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.CreateServiceWithContext(ctx, &input)
 
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -149,21 +148,11 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ServiceAccountRoleArn = aws.String(v.(string))
 	}
 
-	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+	_, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout),
 		func() (interface{}, error) {
 			return conn.CreateAddonWithContext(ctx, input)
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "CREATE_FAILED") {
-				return true, err
-			}
-
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
-				return true, err
-			}
-
-			return false, err
-		},
+		eks.ErrCodeInvalidParameterException, "CREATE_FAILED", "does not exist",
 	)
 
 	if err != nil {
@@ -313,37 +313,20 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Version = aws.String(v.(string))
 	}
 
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout),
 		func() (interface{}, error) {
 			return conn.CreateClusterWithContext(ctx, input)
 		},
-		func(err error) (bool, error) {
-			// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
-				return true, err
-			}
-
-			// InvalidParameterException: Error in role params
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Error in role params") {
-				return true, err
-			}
-
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed because the trusted entity is not correct") {
-				return true, err
-			}
-
-			// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policy is attached: arn:aws:iam::aws:policy/AmazonEKSClusterPolicy
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
-				return true, err
-			}
-
-			// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "IAM role's policy must include") {
-				return true, err
-			}
-
-			return false, err
-		},
+		eks.ErrCodeInvalidParameterException,
+		// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
+		"does not exist",
+		// InvalidParameterException: Error in role params
+		"Error in role params",
+		"Role could not be assumed because the trusted entity is not correct",
+		// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policy is attached: arn:aws:iam::aws:policy/AmazonEKSClusterPolicy
+		"The provided role doesn't have the Amazon EKS Managed Policies associated with it",
+		// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
+		"IAM role's policy must include",
 	)
 
 	if err != nil {
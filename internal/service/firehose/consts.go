@@ -7,3 +7,14 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// iamPropagationErrorMessages are InvalidArgumentException messages returned while a newly created or updated IAM role is propagating.
+var iamPropagationErrorMessages = []string{
+	// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
+	"Access was denied",
+	"is not authorized to",
+	"Please make sure the role specified in VpcConfiguration has permissions",
+	// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
+	"Verify that the IAM role has access",
+	"Firehose is unable to assume role",
+}
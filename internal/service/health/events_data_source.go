@@ -0,0 +1,252 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_health_events")
+func DataSourceEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entity_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"entity_values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventStatusCode_Values(), false),
+				},
+			},
+			"event_type_categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(health.EventTypeCategory_Values(), false),
+				},
+			},
+			"event_type_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_scope_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthConn()
+
+	filter := &health.EventFilter{}
+
+	if v, ok := d.GetOk("availability_zones"); ok && v.(*schema.Set).Len() > 0 {
+		filter.AvailabilityZones = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("entity_arns"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EntityArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("entity_values"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EntityValues = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_status_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventStatusCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCategories = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCodes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Regions = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("services"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Services = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	input := &health.DescribeEventsInput{
+		Filter: filter,
+	}
+
+	events, err := findEvents(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Health Events: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("events", flattenEvents(events)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting events: %s", err)
+	}
+
+	return diags
+}
+
+func findEvents(ctx context.Context, conn *health.Health, input *health.DescribeEventsInput) ([]*health.Event, error) {
+	var output []*health.Event
+
+	err := conn.DescribeEventsPagesWithContext(ctx, input, func(page *health.DescribeEventsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Events {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenEvents(apiObjects []*health.Event) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenEvent(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenEvent(apiObject *health.Event) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                 aws.StringValue(apiObject.Arn),
+		"availability_zone":   aws.StringValue(apiObject.AvailabilityZone),
+		"event_scope_code":    aws.StringValue(apiObject.EventScopeCode),
+		"event_type_category": aws.StringValue(apiObject.EventTypeCategory),
+		"event_type_code":     aws.StringValue(apiObject.EventTypeCode),
+		"region":              aws.StringValue(apiObject.Region),
+		"service":             aws.StringValue(apiObject.Service),
+		"status_code":         aws.StringValue(apiObject.StatusCode),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastUpdatedTime; v != nil {
+		tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
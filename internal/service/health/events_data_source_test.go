@@ -0,0 +1,76 @@
+package health_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func init() {
+	acctest.RegisterServiceErrorCheckFunc(health.EndpointsID, testAccErrorCheckSkip)
+}
+
+// The AWS Health API requires a Business, Enterprise On-Ramp or Enterprise Support plan.
+func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
+	return acctest.ErrorCheckSkipMessagesContaining(t,
+		"SubscriptionRequiredException",
+	)
+}
+
+func TestAccHealthEventsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, health.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthEventsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_health_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, health.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventsDataSourceConfig_filter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_status_codes.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_type_categories.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEventsDataSourceConfig_basic = `
+data "aws_health_events" "test" {}
+`
+
+const testAccEventsDataSourceConfig_filter = `
+data "aws_region" "current" {}
+
+data "aws_health_events" "test" {
+  event_status_codes    = ["open", "upcoming"]
+  event_type_categories = ["scheduledChange"]
+  regions               = [data.aws_region.current.name]
+  services              = ["EC2"]
+}
+`
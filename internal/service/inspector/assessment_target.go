@@ -19,6 +19,8 @@ import (
 // @SDKResource("aws_inspector_assessment_target")
 func ResourceAssessmentTarget() *schema.Resource {
 	return &schema.Resource{
+		DeprecationMessage: inspectorClassicDeprecationMessage,
+
 		CreateWithoutTimeout: resourceAssessmentTargetCreate,
 		ReadWithoutTimeout:   resourceAssessmentTargetRead,
 		UpdateWithoutTimeout: resourceAssessmentTargetUpdate,
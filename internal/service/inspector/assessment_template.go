@@ -27,6 +27,8 @@ const (
 // @Tags(identifierAttribute="id")
 func ResourceAssessmentTemplate() *schema.Resource {
 	return &schema.Resource{
+		DeprecationMessage: inspectorClassicDeprecationMessage,
+
 		CreateWithoutTimeout: resourceAssessmentTemplateCreate,
 		ReadWithoutTimeout:   resourceAssessmentTemplateRead,
 		UpdateWithoutTimeout: resourceAssessmentTemplateUpdate,
@@ -0,0 +1,5 @@
+package inspector
+
+const (
+	inspectorClassicDeprecationMessage = `Amazon Inspector Classic has reached end of support and will be removed in a future version. Use the aws_inspector2_* resources for Amazon Inspector instead.`
+)
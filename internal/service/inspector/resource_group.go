@@ -15,6 +15,8 @@ import (
 // @SDKResource("aws_inspector_resource_group")
 func ResourceResourceGroup() *schema.Resource {
 	return &schema.Resource{
+		DeprecationMessage: inspectorClassicDeprecationMessage,
+
 		CreateWithoutTimeout: resourceResourceGroupCreate,
 		ReadWithoutTimeout:   resourceResourceGroupRead,
 		DeleteWithoutTimeout: resourceResourceGroupDelete,
@@ -15,6 +15,8 @@ import (
 // @SDKDataSource("aws_inspector_rules_packages")
 func DataSourceRulesPackages() *schema.Resource {
 	return &schema.Resource{
+		DeprecationMessage: inspectorClassicDeprecationMessage,
+
 		ReadWithoutTimeout: dataSourceRulesPackagesRead,
 
 		Schema: map[string]*schema.Schema{
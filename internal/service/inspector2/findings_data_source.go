@@ -0,0 +1,289 @@
+package inspector2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// stringFilterCriteria maps the supported string filter criteria to their setters.
+var stringFilterCriteria = map[string]func(*types.FilterCriteria, []types.StringFilter){
+	"aws_account_id":            func(c *types.FilterCriteria, v []types.StringFilter) { c.AwsAccountId = v },
+	"ecr_image_repository_name": func(c *types.FilterCriteria, v []types.StringFilter) { c.EcrImageRepositoryName = v },
+	"finding_arn":               func(c *types.FilterCriteria, v []types.StringFilter) { c.FindingArn = v },
+	"finding_status":            func(c *types.FilterCriteria, v []types.StringFilter) { c.FindingStatus = v },
+	"finding_type":              func(c *types.FilterCriteria, v []types.StringFilter) { c.FindingType = v },
+	"resource_id":               func(c *types.FilterCriteria, v []types.StringFilter) { c.ResourceId = v },
+	"resource_type":             func(c *types.FilterCriteria, v []types.StringFilter) { c.ResourceType = v },
+	"severity":                  func(c *types.FilterCriteria, v []types.StringFilter) { c.Severity = v },
+	"title":                     func(c *types.FilterCriteria, v []types.StringFilter) { c.Title = v },
+	"vulnerability_id":          func(c *types.FilterCriteria, v []types.StringFilter) { c.VulnerabilityId = v },
+}
+
+// @SDKDataSource("aws_inspector2_findings")
+func DataSourceFindings() *schema.Resource {
+	filterCriteriaSchema := make(map[string]*schema.Schema, len(stringFilterCriteria))
+	for k := range stringFilterCriteria {
+		filterCriteriaSchema[k] = stringFilterSchema()
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: filterCriteriaSchema,
+				},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inspector_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sort_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.SortField](),
+						},
+						"sort_order": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.SortOrder](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.StringComparison](),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameFindings = "Findings Data Source"
+)
+
+// findingsPageSize is the largest page size supported by ListFindings.
+const findingsPageSize = 100
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client()
+
+	in := &inspector2.ListFindingsInput{}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sort_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		in.SortCriteria = &types.SortCriteria{
+			Field:     types.SortField(tfMap["field"].(string)),
+			SortOrder: types.SortOrder(tfMap["sort_order"].(string)),
+		}
+	}
+
+	maxResults := d.Get("max_results").(int)
+	pageSize := findingsPageSize
+	if maxResults > 0 && maxResults < pageSize {
+		pageSize = maxResults
+	}
+	in.MaxResults = aws.Int32(int32(pageSize))
+
+	var findings []interface{}
+	paginator := inspector2.NewListFindingsPaginator(conn, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionReading, DSNameFindings, "", err)
+		}
+
+		for _, finding := range page.Findings {
+			findings = append(findings, flattenFinding(finding))
+
+			if maxResults > 0 && len(findings) >= maxResults {
+				break
+			}
+		}
+
+		if maxResults > 0 && len(findings) >= maxResults {
+			break
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("findings", findings); err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionSetting, DSNameFindings, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	apiObject := &types.FilterCriteria{}
+
+	for k, setter := range stringFilterCriteria {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			setter(apiObject, expandStringFilters(v.List()))
+		}
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.StringFilter{
+			Comparison: types.StringComparison(tfMap["comparison"].(string)),
+			Value:      aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFinding(apiObject types.Finding) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"aws_account_id":  aws.ToString(apiObject.AwsAccountId),
+		"description":     aws.ToString(apiObject.Description),
+		"finding_arn":     aws.ToString(apiObject.FindingArn),
+		"inspector_score": aws.ToFloat64(apiObject.InspectorScore),
+		"severity":        string(apiObject.Severity),
+		"status":          string(apiObject.Status),
+		"title":           aws.ToString(apiObject.Title),
+		"type":            string(apiObject.Type),
+	}
+
+	if v := apiObject.FirstObservedAt; v != nil {
+		tfMap["first_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastObservedAt; v != nil {
+		tfMap["last_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.UpdatedAt; v != nil {
+		tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.PackageVulnerabilityDetails; v != nil {
+		tfMap["vulnerability_id"] = aws.ToString(v.VulnerabilityId)
+	}
+
+	var resourceIDs []string
+	for _, resource := range apiObject.Resources {
+		resourceIDs = append(resourceIDs, aws.ToString(resource.Id))
+	}
+	tfMap["resource_ids"] = resourceIDs
+
+	return tfMap
+}
@@ -72,6 +72,33 @@ func TestAccKeyspacesKeyspace_disappears(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_namePrefix("tf_acc_test_"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "name", "tf_acc_test_"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf_acc_test_"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesKeyspace_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := "tf_acc_test_" + sdkacctest.RandString(20)
@@ -170,6 +197,14 @@ resource "aws_keyspaces_keyspace" "test" {
 `, rName)
 }
 
+func testAccKeyspaceConfig_namePrefix(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name_prefix = %[1]q
+}
+`, namePrefix)
+}
+
 func testAccKeyspaceConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
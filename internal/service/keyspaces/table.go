@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -71,9 +72,10 @@ func ResourceTable() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"read_capacity_units": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateFunc:     validation.IntAtLeast(1),
+							DiffSuppressFunc: suppressAutoScaledCapacity,
 						},
 						"throughput_mode": {
 							Type:         schema.TypeString,
@@ -82,9 +84,10 @@ func ResourceTable() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(keyspaces.ThroughputMode_Values(), false),
 						},
 						"write_capacity_units": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateFunc:     validation.IntAtLeast(1),
+							DiffSuppressFunc: suppressAutoScaledCapacity,
 						},
 					},
 				},
@@ -132,17 +135,16 @@ func ResourceTable() *schema.Resource {
 					},
 				},
 			},
+			"ignore_capacity_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"keyspace_name": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
-				ValidateFunc: validation.All(
-					validation.StringLenBetween(1, 48),
-					validation.StringMatch(
-						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_]{1,47}$`),
-						"The name must consist of alphanumerics and underscores.",
-					),
-				),
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validName,
 			},
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
@@ -196,6 +198,7 @@ func ResourceTable() *schema.Resource {
 						"column": {
 							Type:     schema.TypeSet,
 							Required: true,
+							Set:      columnDefinitionHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": {
@@ -210,8 +213,9 @@ func ResourceTable() *schema.Resource {
 										),
 									},
 									"type": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressEquivalentCQLType,
 										ValidateFunc: validation.StringMatch(
 											regexp.MustCompile(`^[a-z0-9]+(\<[a-z0-9]+(, *[a-z0-9]+){0,1}\>)?$`),
 											"The type must consist of lower case alphanumerics and an optional list of upto two lower case alphanumerics enclosed in angle brackets '<>'.",
@@ -266,16 +270,20 @@ func ResourceTable() *schema.Resource {
 				},
 			},
 			"table_name": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
-				ValidateFunc: validation.All(
-					validation.StringLenBetween(1, 48),
-					validation.StringMatch(
-						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_]{1,47}$`),
-						"The name must consist of alphanumerics and underscores.",
-					),
-				),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"table_name", "table_name_prefix"},
+				ValidateFunc: validName,
+			},
+			"table_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"table_name", "table_name_prefix"},
+				ValidateFunc: validNamePrefix,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -301,7 +309,7 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).KeyspacesConn()
 
 	keyspaceName := d.Get("keyspace_name").(string)
-	tableName := d.Get("table_name").(string)
+	tableName := create.Name(d.Get("table_name").(string), d.Get("table_name_prefix").(string))
 	id := TableCreateResourceID(keyspaceName, tableName)
 	input := &keyspaces.CreateTableInput{
 		KeyspaceName: aws.String(keyspaceName),
@@ -396,6 +404,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("encryption_specification", nil)
 	}
+	d.Set("ignore_capacity_drift", d.Get("ignore_capacity_drift"))
 	d.Set("keyspace_name", table.KeyspaceName)
 	if table.PointInTimeRecovery != nil {
 		if err := d.Set("point_in_time_recovery", []interface{}{flattenPointInTimeRecoverySummary(table.PointInTimeRecovery)}); err != nil {
@@ -412,6 +421,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		d.Set("schema_definition", nil)
 	}
 	d.Set("table_name", table.TableName)
+	d.Set("table_name_prefix", create.NamePrefixFromName(aws.StringValue(table.TableName)))
 	if table.Ttl != nil {
 		if err := d.Set("ttl", []interface{}{flattenTimeToLive(table.Ttl)}); err != nil {
 			return diag.Errorf("setting ttl: %s", err)
@@ -1215,3 +1225,54 @@ func flattenStaticColumns(apiObjects []*keyspaces.StaticColumn) []interface{} {
 
 	return tfList
 }
+
+// cqlTypeAliases maps CQL type aliases to the canonical type returned by the Keyspaces API.
+var cqlTypeAliases = map[string]string{
+	"varchar": "text",
+}
+
+// cqlTypeTokenRegexp matches the individual type names in a (possibly parameterized) CQL type, e.g. "map<varchar, int>".
+var cqlTypeTokenRegexp = regexp.MustCompile(`[a-z0-9]+`)
+
+// normalizeCQLType returns the canonical form of a CQL type: aliases are resolved and whitespace is removed.
+func normalizeCQLType(v string) string {
+	v = strings.Join(strings.Fields(strings.ToLower(v)), "")
+
+	return cqlTypeTokenRegexp.ReplaceAllStringFunc(v, func(token string) string {
+		if alias, ok := cqlTypeAliases[token]; ok {
+			return alias
+		}
+
+		return token
+	})
+}
+
+func suppressEquivalentCQLType(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeCQLType(old) == normalizeCQLType(new)
+}
+
+// columnDefinitionHash hashes a column definition on its name and normalized type so that
+// configured type aliases match the canonical types returned by the API.
+func columnDefinitionHash(v interface{}) int {
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	name, _ := tfMap["name"].(string)
+	typ, _ := tfMap["type"].(string)
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s-", name, normalizeCQLType(typ)))
+}
+
+// suppressAutoScaledCapacity suppresses differences in provisioned capacity units once the table exists
+// if capacity drift is to be ignored, e.g. because the capacity is managed by Application Auto Scaling.
+func suppressAutoScaledCapacity(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("ignore_capacity_drift").(bool) {
+		return false
+	}
+
+	// Capacity is set when the table is created or switched to provisioned mode.
+	return old != "" && old != "0" && new != "" && new != "0"
+}
@@ -0,0 +1,131 @@
+package keyspaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_keyspaces_table_capacity")
+func DataSourceTableCapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableCapacityRead,
+
+		Schema: map[string]*schema.Schema{
+			"autoscaling_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"keyspace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"read_capacity_autoscaling": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tableCapacityAutoScalingSchema(),
+			},
+			"read_capacity_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"throughput_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"write_capacity_autoscaling": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tableCapacityAutoScalingSchema(),
+			},
+			"write_capacity_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func tableCapacityAutoScalingSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTableCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KeyspacesConn()
+
+	keyspaceName := d.Get("keyspace_name").(string)
+	tableName := d.Get("table_name").(string)
+	id := TableCreateResourceID(keyspaceName, tableName)
+
+	table, err := FindTableByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if v := table.CapacitySpecification; v != nil {
+		d.Set("read_capacity_units", v.ReadCapacityUnits)
+		d.Set("throughput_mode", v.ThroughputMode)
+		d.Set("write_capacity_units", v.WriteCapacityUnits)
+	}
+
+	// Keyspaces tables are scaled by Application Auto Scaling.
+	appAutoScalingConn := meta.(*conns.AWSClient).AppAutoScalingConn()
+	resourceID := fmt.Sprintf("keyspace/%s/table/%s", keyspaceName, tableName)
+	autoScalingEnabled := false
+
+	for attr, dimension := range map[string]string{
+		"read_capacity_autoscaling":  applicationautoscaling.ScalableDimensionCassandraTableReadCapacityUnits,
+		"write_capacity_autoscaling": applicationautoscaling.ScalableDimensionCassandraTableWriteCapacityUnits,
+	} {
+		target, err := tfappautoscaling.FindTargetByThreePartKey(ctx, appAutoScalingConn, resourceID, applicationautoscaling.ServiceNamespaceCassandra, dimension)
+
+		if tfresource.NotFound(err) {
+			d.Set(attr, nil)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s) Application Auto Scaling target (%s): %s", id, dimension, err)
+		}
+
+		autoScalingEnabled = true
+
+		if err := d.Set(attr, []interface{}{map[string]interface{}{
+			"max_capacity": aws.Int64Value(target.MaxCapacity),
+			"min_capacity": aws.Int64Value(target.MinCapacity),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", attr, err)
+		}
+	}
+
+	d.Set("autoscaling_enabled", autoScalingEnabled)
+
+	return diags
+}
@@ -0,0 +1,121 @@
+package keyspaces_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/keyspaces"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKeyspacesTableCapacityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	dataSourceName := "data.aws_keyspaces_table_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableCapacityDataSourceConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "autoscaling_enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "read_capacity_autoscaling.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "read_capacity_units", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "throughput_mode", "PROVISIONED"),
+					resource.TestCheckResourceAttr(dataSourceName, "write_capacity_autoscaling.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "write_capacity_units", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesTableCapacityDataSource_autoScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	dataSourceName := "data.aws_keyspaces_table_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableCapacityDataSourceConfig_autoScaling(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "autoscaling_enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "read_capacity_autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "read_capacity_autoscaling.0.max_capacity", "20"),
+					resource.TestCheckResourceAttr(dataSourceName, "read_capacity_autoscaling.0.min_capacity", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "write_capacity_autoscaling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableCapacityDataSourceConfig_base(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name         = aws_keyspaces_keyspace.test.name
+  table_name            = %[2]q
+  ignore_capacity_drift = true
+
+  capacity_specification {
+    read_capacity_units  = 5
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = 5
+  }
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName1, rName2)
+}
+
+func testAccTableCapacityDataSourceConfig_basic(rName1, rName2 string) string {
+	return acctest.ConfigCompose(testAccTableCapacityDataSourceConfig_base(rName1, rName2), `
+data "aws_keyspaces_table_capacity" "test" {
+  keyspace_name = aws_keyspaces_table.test.keyspace_name
+  table_name    = aws_keyspaces_table.test.table_name
+}
+`)
+}
+
+func testAccTableCapacityDataSourceConfig_autoScaling(rName1, rName2 string) string {
+	return acctest.ConfigCompose(testAccTableCapacityDataSourceConfig_base(rName1, rName2), `
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 20
+  min_capacity       = 5
+  resource_id        = "keyspace/${aws_keyspaces_table.test.keyspace_name}/table/${aws_keyspaces_table.test.table_name}"
+  scalable_dimension = "cassandra:table:ReadCapacityUnits"
+  service_namespace  = "cassandra"
+}
+
+data "aws_keyspaces_table_capacity" "test" {
+  keyspace_name = aws_keyspaces_table.test.keyspace_name
+  table_name    = aws_keyspaces_table.test.table_name
+
+  depends_on = [aws_appautoscaling_target.test]
+}
+`)
+}
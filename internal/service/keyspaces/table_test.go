@@ -96,6 +96,46 @@ func TestAccKeyspacesTable_disappears(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_namePrefixCreateBeforeDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 keyspaces.GetTableOutput
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_namePrefixCreateBeforeDestroy(rName, "message"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "keyspace_name", rName),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "table_name", "tf_acc_test_"),
+					resource.TestCheckResourceAttr(resourceName, "table_name_prefix", "tf_acc_test_"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Changing the partition key forces replacement. The replacement table is
+				// created under a new generated name before the original table is destroyed.
+				Config: testAccTableConfig_namePrefixCreateBeforeDestroy(rName, "id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v2),
+					testAccCheckTableRecreated(&v1, &v2),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "table_name", "tf_acc_test_"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v keyspaces.GetTableOutput
@@ -237,6 +277,85 @@ func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_typeAliases(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_typeAliases(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schema_definition.0.column.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schema_definition.0.column.*", map[string]string{
+						"name": "id",
+						"type": "text",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schema_definition.0.column.*", map[string]string{
+						"name": "nicknames",
+						"type": "list<text>",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schema_definition.0.column.*", map[string]string{
+						"name": "tags",
+						"type": "map<text, int>",
+					}),
+				),
+			},
+			{
+				// The API returns canonical types; configured aliases must not produce a diff.
+				Config:   testAccTableConfig_typeAliases(rName1, rName2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesTable_ignoreCapacityDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_ignoreCapacityDrift(rName1, rName2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.read_capacity_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.write_capacity_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_capacity_drift", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// ignore_capacity_drift is not returned by the API.
+				ImportStateVerifyIgnore: []string{"ignore_capacity_drift"},
+			},
+			{
+				// Capacity changes are ignored once the table exists.
+				Config:   testAccTableConfig_ignoreCapacityDrift(rName1, rName2, 10),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 keyspaces.GetTableOutput
@@ -552,6 +671,39 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_namePrefixCreateBeforeDestroy(rName, partitionKey string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name     = aws_keyspaces_keyspace.test.name
+  table_name_prefix = "tf_acc_test_"
+
+  schema_definition {
+    column {
+      name = "id"
+      type = "ascii"
+    }
+
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = %[2]q
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, partitionKey)
+}
+
 func testAccTableConfig_tags1(rName1, rName2, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
@@ -701,6 +853,71 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_typeAliases(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "tags"
+      type = "map<varchar,int>"
+    }
+
+    column {
+      name = "id"
+      type = "varchar"
+    }
+
+    column {
+      name = "nicknames"
+      type = "list<varchar>"
+    }
+
+    partition_key {
+      name = "id"
+    }
+  }
+}
+`, rName1, rName2)
+}
+
+func testAccTableConfig_ignoreCapacityDrift(rName1, rName2 string, capacityUnits int) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name         = aws_keyspaces_keyspace.test.name
+  table_name            = %[2]q
+  ignore_capacity_drift = true
+
+  capacity_specification {
+    read_capacity_units  = %[3]d
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = %[3]d
+  }
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+}
+`, rName1, rName2, capacityUnits)
+}
+
 func testAccTableConfig_allAttributes(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
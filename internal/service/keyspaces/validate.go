@@ -0,0 +1,29 @@
+package keyspaces
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	nameMaxLength = 48
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, nameMaxLength),
+	validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_]{1,47}$`),
+		"The name must consist of alphanumerics and underscores.",
+	),
+)
+
+// validNamePrefix validates a name prefix, leaving room for the generated unique suffix.
+var validNamePrefix = validation.All(
+	validation.StringLenBetween(1, nameMaxLength-id.UniqueIDSuffixLength),
+	validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_]*$`),
+		"The name prefix must consist of alphanumerics and underscores.",
+	),
+)
@@ -0,0 +1,336 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	aliasDeploymentIDPartCount = 2
+
+	// aliasDeploymentAlarmPollInterval is how often CloudWatch alarms are checked while traffic is being shifted.
+	aliasDeploymentAlarmPollInterval = 15 * time.Second
+)
+
+// @SDKResource("aws_lambda_alias_deployment")
+func ResourceAliasDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAliasDeploymentCreate,
+		ReadWithoutTimeout:   resourceAliasDeploymentRead,
+		UpdateWithoutTimeout: resourceAliasDeploymentUpdate,
+		DeleteWithoutTimeout: resourceAliasDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      aliasDeploymentTypeAllAtOnce,
+				ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 2880),
+			},
+			"percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 99),
+			},
+			"previous_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliasDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	functionName := d.Get("function_name").(string)
+	aliasName := d.Get("alias_name").(string)
+	id, err := flex.FlattenResourceId([]string{functionName, aliasName}, aliasDeploymentIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if err := deployAlias(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Alias Deployment (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAliasDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceAliasDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), aliasDeploymentIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, aliasName := parts[0], parts[1]
+	output, err := FindAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Alias Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Alias Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias_name", aliasName)
+	d.Set("function_name", functionName)
+	// An interrupted deployment leaves the alias on the previous version, causing a diff on the next plan.
+	d.Set("function_version", output.FunctionVersion)
+
+	return diags
+}
+
+func resourceAliasDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("function_version") {
+		if err := deployAlias(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Alias Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAliasDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceAliasDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// There is nothing to delete; the alias keeps routing all traffic to its current version.
+	log.Printf("[DEBUG] Removing Lambda Alias Deployment (%s) from state", d.Id())
+
+	return diags
+}
+
+// deployAlias shifts the alias's traffic from its current version to the configured version.
+// If any of the configured CloudWatch alarms goes into the ALARM state the alias is rolled back to its current version.
+func deployAlias(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+	cloudWatchConn := meta.(*conns.AWSClient).CloudWatchConn()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	functionName := d.Get("function_name").(string)
+	aliasName := d.Get("alias_name").(string)
+	targetVersion := d.Get("function_version").(string)
+	alarmNames := flex.ExpandStringValueSet(d.Get("alarms").(*schema.Set))
+	interval := time.Duration(d.Get("interval").(int)) * time.Minute
+
+	alias, err := FindAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+
+	if err != nil {
+		return err
+	}
+
+	previousVersion := aws.StringValue(alias.FunctionVersion)
+
+	if previousVersion == targetVersion {
+		return nil
+	}
+
+	for _, weight := range aliasDeploymentWeights(d.Get("deployment_type").(string), d.Get("percentage").(int)) {
+		if err := updateAliasRouting(ctx, conn, functionName, aliasName, previousVersion, map[string]float64{targetVersion: weight}); err != nil {
+			return err
+		}
+
+		if err := waitAliasDeploymentInterval(ctx, cloudWatchConn, alarmNames, interval); err != nil {
+			// Use a fresh context so that a rollback is still attempted after a timeout.
+			if rollbackErr := updateAliasRouting(context.Background(), conn, functionName, aliasName, previousVersion, nil); rollbackErr != nil {
+				return fmt.Errorf("%s; rolling back to version %s: %w", err, previousVersion, rollbackErr)
+			}
+
+			d.Set("function_version", previousVersion)
+
+			return fmt.Errorf("rolled back to version %s: %w", previousVersion, err)
+		}
+	}
+
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, targetVersion, nil); err != nil {
+		return err
+	}
+
+	d.Set("previous_version", previousVersion)
+
+	return nil
+}
+
+// aliasDeploymentWeights returns the successive weights of traffic routed to the new version before it receives all traffic.
+func aliasDeploymentWeights(deploymentType string, percentage int) []float64 {
+	var weights []float64
+
+	switch deploymentType {
+	case aliasDeploymentTypeCanary:
+		weights = append(weights, float64(percentage)/100)
+	case aliasDeploymentTypeLinear:
+		for v := percentage; v < 100; v += percentage {
+			weights = append(weights, float64(v)/100)
+		}
+	}
+
+	return weights
+}
+
+func updateAliasRouting(ctx context.Context, conn *lambda.Lambda, functionName, aliasName, functionVersion string, additionalVersionWeights map[string]float64) error {
+	input := &lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		FunctionVersion: aws.String(functionVersion),
+		Name:            aws.String(aliasName),
+		RoutingConfig: &lambda.AliasRoutingConfiguration{
+			AdditionalVersionWeights: aws.Float64Map(additionalVersionWeights),
+		},
+	}
+
+	if _, err := conn.UpdateAliasWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating Lambda Alias (%s:%s) routing: %w", functionName, aliasName, err)
+	}
+
+	return nil
+}
+
+// waitAliasDeploymentInterval waits for the specified interval, returning an error as soon as any of the specified alarms is in the ALARM state.
+func waitAliasDeploymentInterval(ctx context.Context, conn *cloudwatch.CloudWatch, alarmNames []string, interval time.Duration) error {
+	deadline := time.Now().Add(interval)
+
+	for {
+		if err := checkAliasDeploymentAlarms(ctx, conn, alarmNames); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+
+		if remaining <= 0 {
+			return nil
+		}
+
+		if remaining > aliasDeploymentAlarmPollInterval {
+			remaining = aliasDeploymentAlarmPollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(remaining):
+		}
+	}
+}
+
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.CloudWatch, alarmNames []string) error {
+	if len(alarmNames) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(alarmNames),
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm, cloudwatch.AlarmTypeMetricAlarm}),
+		StateValue: aws.String(cloudwatch.StateValueAlarm),
+	}
+
+	output, err := conn.DescribeAlarmsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch Alarms: %w", err)
+	}
+
+	if len(output.CompositeAlarms) > 0 && output.CompositeAlarms[0] != nil {
+		v := output.CompositeAlarms[0]
+		return fmt.Errorf("CloudWatch Composite Alarm (%s) is in %s state: %s", aws.StringValue(v.AlarmName), aws.StringValue(v.StateValue), aws.StringValue(v.StateReason))
+	}
+
+	if len(output.MetricAlarms) > 0 && output.MetricAlarms[0] != nil {
+		v := output.MetricAlarms[0]
+		return fmt.Errorf("CloudWatch Metric Alarm (%s) is in %s state: %s", aws.StringValue(v.AlarmName), aws.StringValue(v.StateValue), aws.StringValue(v.StateReason))
+	}
+
+	return nil
+}
+
+func FindAliasByTwoPartKey(ctx context.Context, conn *lambda.Lambda, functionName, aliasName string) (*lambda.AliasConfiguration, error) {
+	input := &lambda.GetAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String(aliasName),
+	}
+
+	output, err := conn.GetAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,172 @@
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaAliasDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.AliasConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	aliasResourceName := "aws_lambda_alias.test"
+	resourceName := "aws_lambda_alias_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasDeploymentConfig_basic(rName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, aliasResourceName, &conf),
+					testAccCheckAliasDeploymentVersion(&conf, "1"),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_type", "AllAtOnce"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"alarms", "deployment_type", "interval", "percentage", "previous_version"},
+			},
+			{
+				Config: testAccAliasDeploymentConfig_basic(rName, "lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, aliasResourceName, &conf),
+					testAccCheckAliasDeploymentVersion(&conf, "2"),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "previous_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAliasDeployment_linear(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.AliasConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	aliasResourceName := "aws_lambda_alias.test"
+	resourceName := "aws_lambda_alias_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasDeploymentConfig_linear(rName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, aliasResourceName, &conf),
+					testAccCheckAliasDeploymentVersion(&conf, "1"),
+				),
+			},
+			{
+				Config: testAccAliasDeploymentConfig_linear(rName, "lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, aliasResourceName, &conf),
+					testAccCheckAliasDeploymentVersion(&conf, "2"),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_type", "Linear"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "previous_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAliasDeploymentVersion(conf *lambda.AliasConfiguration, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v := aws.StringValue(conf.FunctionVersion); v != expected {
+			return fmt.Errorf("Lambda Alias function version: expected %s, got %s", expected, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccAliasDeploymentConfig_base(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/%[2]s"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs16.x"
+  source_code_hash = filebase64sha256("test-fixtures/%[2]s")
+  publish          = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+
+  lifecycle {
+    ignore_changes = [function_version, routing_config]
+  }
+}
+`, rName, filename))
+}
+
+func testAccAliasDeploymentConfig_basic(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasDeploymentConfig_base(rName, filename),
+		`
+resource "aws_lambda_alias_deployment" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  alias_name       = aws_lambda_alias.test.name
+  function_version = aws_lambda_function.test.version
+}
+`)
+}
+
+func testAccAliasDeploymentConfig_linear(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasDeploymentConfig_base(rName, filename),
+		`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = aws_lambda_function.test.function_name
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias_deployment" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  alias_name       = aws_lambda_alias.test.name
+  function_version = aws_lambda_function.test.version
+  deployment_type  = "Linear"
+  percentage       = 50
+  interval         = 1
+  alarms           = [aws_cloudwatch_metric_alarm.test.alarm_name]
+}
+`)
+}
@@ -14,6 +14,20 @@ const (
 	eventSourceMappingStateUpdating  = "Updating"
 )
 
+const (
+	aliasDeploymentTypeAllAtOnce = "AllAtOnce"
+	aliasDeploymentTypeCanary    = "Canary"
+	aliasDeploymentTypeLinear    = "Linear"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeAllAtOnce,
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
+
 const (
 	propagationTimeout = 5 * time.Minute
 )
@@ -472,7 +472,7 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 	// function defined for the task cannot be assumed by Lambda.
 	//
 	// The role may exist, but the permissions may not have propagated, so we retry.
-	eventSourceMappingConfiguration, err := retryEventSourceMapping(ctx, func() (*lambda.EventSourceMappingConfiguration, error) {
+	eventSourceMappingConfiguration, err := retryEventSourceMapping(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout), func() (*lambda.EventSourceMappingConfiguration, error) {
 		return conn.CreateEventSourceMappingWithContext(ctx, input)
 	})
 
@@ -683,7 +683,7 @@ func resourceEventSourceMappingUpdate(ctx context.Context, d *schema.ResourceDat
 		input.TumblingWindowInSeconds = aws.Int64(int64(d.Get("tumbling_window_in_seconds").(int)))
 	}
 
-	_, err := retryEventSourceMapping(ctx, func() (*lambda.EventSourceMappingConfiguration, error) {
+	_, err := retryEventSourceMapping(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout), func() (*lambda.EventSourceMappingConfiguration, error) {
 		return conn.UpdateEventSourceMappingWithContext(ctx, input)
 	})
 
@@ -1238,26 +1238,15 @@ func waitEventSourceMappingUpdate(ctx context.Context, conn *lambda.Lambda, id s
 	return nil, err
 }
 
-func retryEventSourceMapping(ctx context.Context, f func() (*lambda.EventSourceMappingConfiguration, error)) (*lambda.EventSourceMappingConfiguration, error) {
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+func retryEventSourceMapping(ctx context.Context, timeout time.Duration, f func() (*lambda.EventSourceMappingConfiguration, error)) (*lambda.EventSourceMappingConfiguration, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, timeout,
 		func() (interface{}, error) {
 			return f()
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "cannot be assumed by Lambda") {
-				return true, err
-			}
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "execution role does not have permissions") {
-				return true, err
-			}
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "ensure the role can perform") {
-				return true, err
-			}
-
-			return false, err
-		},
+		lambda.ErrCodeInvalidParameterValueException,
+		"cannot be assumed by Lambda",
+		"execution role does not have permissions",
+		"ensure the role can perform",
 	)
 
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -273,6 +274,15 @@ func ResourceFunction() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
+			"resolve_image_digest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resolved_image_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"role": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -411,6 +421,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			resolveImageDigest,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -533,7 +544,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+	_, err := retryFunctionOp(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout), func() (interface{}, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -626,6 +637,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	if output.Code != nil {
 		d.Set("image_uri", output.Code.ImageUri)
+		d.Set("resolved_image_uri", output.Code.ResolvedImageUri)
+	} else {
+		d.Set("resolved_image_uri", nil)
 	}
 	d.Set("invoke_arn", functionInvokeARN(functionARN, meta))
 	d.Set("kms_key_arn", function.KMSKeyArn)
@@ -640,6 +654,8 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	} else {
 		d.Set("reserved_concurrent_executions", -1)
 	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set("resolve_image_digest", d.Get("resolve_image_digest"))
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
 	d.Set("signing_job_arn", function.SigningJobArn)
@@ -859,7 +875,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+		_, err := retryFunctionOp(ctx, meta.(*conns.AWSClient).IAMPropagationTimeout(propagationTimeout), func() (interface{}, error) {
 			return conn.UpdateFunctionConfiguration(ctx, input)
 		})
 
@@ -1201,8 +1217,8 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
-func retryFunctionOp(ctx context.Context, f func() (interface{}, error)) (interface{}, error) { //nolint:unparam
-	output, err := tfresource.RetryWhen(ctx, propagationTimeout,
+func retryFunctionOp(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) { //nolint:unparam
+	output, err := tfresource.RetryWhen(ctx, timeout,
 		f,
 		func(err error) (bool, error) {
 			var ipve *types.InvalidParameterValueException
@@ -1261,6 +1277,67 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// resolveImageDigest plans a code update if the image tag referenced by image_uri now resolves to a different digest than the one the function was deployed from.
+func resolveImageDigest(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("resolve_image_digest").(bool) || d.HasChange("image_uri") {
+		return nil
+	}
+
+	imageURI := d.Get("image_uri").(string)
+
+	if imageURI == "" || strings.Contains(imageURI, "@") {
+		return nil
+	}
+
+	registryID, repositoryName, imageTag, err := parseImageURI(imageURI)
+
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(*conns.AWSClient).ECRConn()
+	input := &ecr.DescribeImagesInput{
+		ImageIds: []*ecr.ImageIdentifier{{
+			ImageTag: aws.String(imageTag),
+		}},
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	output, err := conn.DescribeImagesWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("resolving ECR image (%s) digest: %w", imageURI, err)
+	}
+
+	if len(output.ImageDetails) == 0 || output.ImageDetails[0] == nil {
+		return fmt.Errorf("resolving ECR image (%s) digest: image not found", imageURI)
+	}
+
+	resolvedImageURI := fmt.Sprintf("%s@%s", strings.TrimSuffix(imageURI, ":"+imageTag), aws.ToString(output.ImageDetails[0].ImageDigest))
+
+	if resolvedImageURI != d.Get("resolved_image_uri").(string) {
+		if err := d.SetNew("resolved_image_uri", resolvedImageURI); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var imageURIRegexp = regexp.MustCompile(`^([0-9]{12})\.dkr\.ecr\.[a-z0-9-]+\.[a-z0-9.]+/([^:@]+):([^:@/]+)$`)
+
+// parseImageURI returns the registry ID, repository name and image tag of a tagged ECR image URI.
+func parseImageURI(uri string) (string, string, string, error) {
+	matches := imageURIRegexp.FindStringSubmatch(uri)
+
+	if matches == nil {
+		return "", "", "", fmt.Errorf("unexpected format for ECR image URI (%s), expected ACCOUNT.dkr.ecr.REGION.DOMAIN/REPOSITORY:TAG", uri)
+	}
+
+	return matches[1], matches[2], matches[3], nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1285,6 +1362,7 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("resolved_image_uri") ||
 		d.HasChange("architectures")
 }
 
@@ -1009,6 +1009,8 @@ func TestAccLambdaFunction_image(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.entry_point.0", "/bootstrap-with-handler"),
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.command.0", "app.lambda_handler"),
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.working_directory", "/var/task"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_image_digest", "false"),
+					resource.TestMatchResourceAttr(resourceName, "resolved_image_uri", regexp.MustCompile(`@sha256:[0-9a-f]{64}$`)),
 				),
 			},
 			// Ensure configuration can be imported
@@ -1035,6 +1037,18 @@ func TestAccLambdaFunction_image(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.command.0", "app.another_handler"),
 				),
 			},
+			// Ensure an unchanged image tag is not reported as drift
+			{
+				Config: testAccFunctionConfig_imageResolveDigest(rName, imageV2ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "resolve_image_digest", "true"),
+				),
+			},
+			{
+				Config:   testAccFunctionConfig_imageResolveDigest(rName, imageV2ID),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -3192,6 +3206,24 @@ resource "aws_lambda_function" "test" {
 `, imageID, rName))
 }
 
+func testAccFunctionConfig_imageResolveDigest(rName, imageID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  image_uri            = %[1]q
+  function_name        = %[2]q
+  role                 = aws_iam_role.iam_for_lambda.arn
+  package_type         = "Image"
+  resolve_image_digest = true
+
+  image_config {
+    command = ["app.another_handler"]
+  }
+}
+`, imageID, rName))
+}
+
 func testAccFunctionConfig_architecturesARM64(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -2,13 +2,16 @@ package lambda
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -32,6 +35,19 @@ func DataSourceFunctions() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtimes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
+				},
+			},
+			"tags": tftags.TagsSchema(),
 		},
 	}
 }
@@ -41,8 +57,9 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	input := &lambda.ListFunctionsInput{}
 
-	var functionARNs []string
-	var functionNames []string
+	namePrefix := d.Get("name_prefix").(string)
+	runtimes := d.Get("runtimes").(*schema.Set)
+	var functions []*lambda.FunctionConfiguration
 
 	err := conn.ListFunctionsPagesWithContext(ctx, input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
 		if page == nil {
@@ -54,8 +71,16 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 				continue
 			}
 
-			functionARNs = append(functionARNs, aws.StringValue(function.FunctionArn))
-			functionNames = append(functionNames, aws.StringValue(function.FunctionName))
+			// ListFunctions has no server-side filters for these attributes.
+			if namePrefix != "" && !strings.HasPrefix(aws.StringValue(function.FunctionName), namePrefix) {
+				continue
+			}
+
+			if runtimes.Len() > 0 && !runtimes.Contains(aws.StringValue(function.Runtime)) {
+				continue
+			}
+
+			functions = append(functions, function)
 		}
 
 		return !lastPage
@@ -65,6 +90,32 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		return create.DiagError(names.Lambda, create.ErrActionReading, DSNameFunctions, "", err)
 	}
 
+	var functionARNs []string
+	var functionNames []string
+
+	tagsToMatch := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	for _, function := range functions {
+		functionARN := aws.StringValue(function.FunctionArn)
+
+		if len(tagsToMatch) > 0 {
+			output, err := conn.ListTagsWithContext(ctx, &lambda.ListTagsInput{
+				Resource: aws.String(functionARN),
+			})
+
+			if err != nil {
+				return create.DiagError(names.Lambda, create.ErrActionReading, DSNameFunctions, functionARN, err)
+			}
+
+			if !KeyValueTags(ctx, aws.StringValueMap(output.Tags)).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		functionARNs = append(functionARNs, functionARN)
+		functionNames = append(functionNames, aws.StringValue(function.FunctionName))
+	}
+
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set("function_arns", functionARNs)
 	d.Set("function_names", functionNames)
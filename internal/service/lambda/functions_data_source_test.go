@@ -1,6 +1,7 @@
 package lambda_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -30,6 +31,31 @@ func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_filters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_names.0", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName+"_other_runtime", "function_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccFunctionsDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName, rName, rName, rName), `
 data "aws_lambda_functions" "test" {
@@ -37,3 +63,35 @@ data "aws_lambda_functions" "test" {
 }
 `)
 }
+
+func testAccFunctionsDataSourceConfig_filters(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_lambda_functions" "test" {
+  name_prefix = %[1]q
+  runtimes    = [aws_lambda_function.test.runtime]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_lambda_functions" "test_other_runtime" {
+  name_prefix = %[1]q
+  runtimes    = ["python3.10"]
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
@@ -58,6 +58,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAlias,
 			TypeName: "aws_lambda_alias",
 		},
+		{
+			Factory:  ResourceAliasDeployment,
+			TypeName: "aws_lambda_alias_deployment",
+		},
 		{
 			Factory:  ResourceCodeSigningConfig,
 			TypeName: "aws_lambda_code_signing_config",
@@ -102,6 +106,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 		},
+		{
+			Factory:  ResourceRuntimeManagementConfig,
+			TypeName: "aws_lambda_runtime_management_config",
+		},
 	}
 }
 
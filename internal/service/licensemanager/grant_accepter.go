@@ -2,11 +2,15 @@ package licensemanager
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -25,12 +29,18 @@ func ResourceGrantAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantAccepterCreate,
 		ReadWithoutTimeout:   resourceGrantAccepterRead,
+		UpdateWithoutTimeout: resourceGrantAccepterUpdate,
 		DeleteWithoutTimeout: resourceGrantAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"allowed_operations": {
 				Type:     schema.TypeSet,
@@ -40,6 +50,12 @@ func ResourceGrantAccepter() *schema.Resource {
 				},
 				Description: "Allowed operations for the grant.",
 			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the accepted grant is activated in the grantee account.",
+			},
 			"home_region": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -101,6 +117,12 @@ func resourceGrantAccepterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(out.GrantArn))
 
+	if v, ok := d.GetOkExists("enabled"); ok {
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), v.(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.LicenseManager, create.ErrActionCreating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
 	return resourceGrantAccepterRead(ctx, d, meta)
 }
 
@@ -120,6 +142,7 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("allowed_operations", out.GrantedOperations)
+	d.Set("enabled", aws.StringValue(out.GrantStatus) == licensemanager.GrantStatusActive)
 	d.Set("grant_arn", out.GrantArn)
 	d.Set("home_region", out.HomeRegion)
 	d.Set("license_arn", out.LicenseArn)
@@ -132,6 +155,18 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceGrantAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
+
+	if d.HasChange("enabled") {
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), d.Get("enabled").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.LicenseManager, create.ErrActionUpdating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
+	return resourceGrantAccepterRead(ctx, d, meta)
+}
+
 func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
@@ -179,3 +214,98 @@ func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.Licen
 
 	return entry, nil
 }
+
+// updateGrantAccepterStatus activates or deactivates an accepted grant in the grantee account.
+func updateGrantAccepterStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn string, enabled bool, timeout time.Duration) error {
+	status := licensemanager.GrantStatusDisabled
+	if enabled {
+		status = licensemanager.GrantStatusActive
+	}
+
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken: aws.String(id.UniqueId()),
+		GrantArn:    aws.String(arn),
+		Status:      aws.String(status),
+	}
+
+	if _, err := conn.CreateGrantVersionWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	if _, err := waitGrantAccepterStatus(ctx, conn, arn, status, timeout); err != nil {
+		return fmt.Errorf("waiting for grant status (%s): %w", status, err)
+	}
+
+	return nil
+}
+
+func findReceivedGrantByARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
+	in := &licensemanager.ListReceivedGrantsInput{
+		GrantArns: aws.StringSlice([]string{arn}),
+	}
+
+	out, err := conn.ListReceivedGrantsWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range out.Grants {
+		if arn == aws.StringValue(grant.GrantArn) {
+			return grant, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusGrantAccepter(ctx context.Context, conn *licensemanager.LicenseManager, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReceivedGrantByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.GrantStatus), nil
+	}
+}
+
+func waitGrantAccepterStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn, status string, timeout time.Duration) (*licensemanager.Grant, error) {
+	pending := []string{licensemanager.GrantStatusPendingWorkflow, licensemanager.GrantStatusWorkflowCompleted}
+	if status == licensemanager.GrantStatusActive {
+		pending = append(pending, licensemanager.GrantStatusDisabled)
+	} else {
+		pending = append(pending, licensemanager.GrantStatusActive)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{status},
+		Refresh: statusGrantAccepter(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.Grant); ok {
+		if v := aws.StringValue(output.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -92,6 +92,44 @@ func testAccGrantAccepter_disappears(t *testing.T) {
 	})
 }
 
+func testAccGrantAccepter_enabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+	principal := envvar.SkipIfEmpty(t, principalKey, envVarPrincipalKeyError)
+	homeRegion := envvar.SkipIfEmpty(t, homeRegionKey, envVarHomeRegionError)
+	resourceName := "aws_licensemanager_grant_accepter.test"
+
+	providers := make(map[string]*schema.Provider)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, licensemanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             acctest.CheckWithNamedProviders(testAccCheckGrantAccepterDestroyWithProvider(ctx), providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantAccepterConfig_enabled(licenseARN, rName, principal, homeRegion, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", licensemanager.GrantStatusActive),
+				),
+			},
+			{
+				Config: testAccGrantAccepterConfig_enabled(licenseARN, rName, principal, homeRegion, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", licensemanager.GrantStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGrantAccepterExists(ctx context.Context, n string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -146,6 +184,23 @@ func testAccCheckGrantAccepterDestroyWithProvider(ctx context.Context) acctest.T
 }
 
 func testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion string) string {
+	return testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion, `
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn = aws_licensemanager_grant.test.arn
+}
+`)
+}
+
+func testAccGrantAccepterConfig_enabled(licenseARN, rName, principal, homeRegion string, enabled bool) string {
+	return testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion, fmt.Sprintf(`
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn = aws_licensemanager_grant.test.arn
+  enabled   = %[1]t
+}
+`, enabled))
+}
+
+func testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion, accepterConfig string) string {
 	principalArn, _ := arn.Parse(principal)
 	roleARN := arn.ARN{
 		Partition: principalArn.Partition,
@@ -161,11 +216,8 @@ provider %[1]q {
 		role_arn = %[2]q
 	}
 }`, acctest.ProviderName, roleARN),
+		accepterConfig,
 		fmt.Sprintf(`
-resource "aws_licensemanager_grant_accepter" "test" {
-  grant_arn = aws_licensemanager_grant.test.arn
-}
-
 data "aws_licensemanager_received_license" "test" {
   provider    = awsalternate
   license_arn = %[1]q
@@ -41,6 +41,7 @@ func TestAccLicenseManagerGrant_serial(t *testing.T) {
 		"grant_accepter": {
 			"basic":      testAccGrantAccepter_basic,
 			"disappears": testAccGrantAccepter_disappears,
+			"enabled":    testAccGrantAccepter_enabled,
 		},
 		"grant_data_source": {
 			"basic": testAccGrantsDataSource_basic,
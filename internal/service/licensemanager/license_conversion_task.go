@@ -0,0 +1,263 @@
+package licensemanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResLicenseConversionTask = "License Conversion Task"
+)
+
+// @SDKResource("aws_licensemanager_license_conversion_task")
+func ResourceLicenseConversionTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLicenseConversionTaskCreate,
+		ReadWithoutTimeout:   resourceLicenseConversionTaskRead,
+		DeleteWithoutTimeout: resourceLicenseConversionTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_license_context": licenseContextSchema(),
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license conversion task ended.",
+			},
+			"license_conversion_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the usage operation value of the resource was changed.",
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  "Amazon Resource Name (ARN) of the resource to convert, e.g. an EC2 instance.",
+			},
+			"source_license_context": licenseContextSchema(),
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license conversion task started.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the license conversion task.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status message of the license conversion task.",
+			},
+		},
+	}
+}
+
+func licenseContextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"usage_operation": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Usage operation value that corresponds to the license type, e.g. `RunInstances:0010`.",
+				},
+			},
+		},
+	}
+}
+
+func resourceLicenseConversionTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
+
+	resourceARN := d.Get("resource_arn").(string)
+	in := &licensemanager.CreateLicenseConversionTaskForResourceInput{
+		DestinationLicenseContext: expandLicenseConversionContext(d.Get("destination_license_context").([]interface{})),
+		ResourceArn:               aws.String(resourceARN),
+		SourceLicenseContext:      expandLicenseConversionContext(d.Get("source_license_context").([]interface{})),
+	}
+
+	out, err := conn.CreateLicenseConversionTaskForResourceWithContext(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.LicenseManager, create.ErrActionCreating, ResLicenseConversionTask, resourceARN, err)
+	}
+
+	d.SetId(aws.StringValue(out.LicenseConversionTaskId))
+
+	if _, err := waitLicenseConversionTaskSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.LicenseManager, create.ErrActionWaitingForCreation, ResLicenseConversionTask, d.Id(), err)
+	}
+
+	return resourceLicenseConversionTaskRead(ctx, d, meta)
+}
+
+func resourceLicenseConversionTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
+
+	out, err := FindLicenseConversionTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.LicenseManager, create.ErrActionReading, ResLicenseConversionTask, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LicenseManager, create.ErrActionReading, ResLicenseConversionTask, d.Id(), err)
+	}
+
+	if err := d.Set("destination_license_context", flattenLicenseConversionContext(out.DestinationLicenseContext)); err != nil {
+		return create.DiagError(names.LicenseManager, create.ErrActionSetting, ResLicenseConversionTask, d.Id(), err)
+	}
+	if out.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(out.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	if out.LicenseConversionTime != nil {
+		d.Set("license_conversion_time", aws.TimeValue(out.LicenseConversionTime).Format(time.RFC3339))
+	} else {
+		d.Set("license_conversion_time", nil)
+	}
+	d.Set("resource_arn", out.ResourceArn)
+	if err := d.Set("source_license_context", flattenLicenseConversionContext(out.SourceLicenseContext)); err != nil {
+		return create.DiagError(names.LicenseManager, create.ErrActionSetting, ResLicenseConversionTask, d.Id(), err)
+	}
+	if out.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(out.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("status", out.Status)
+	d.Set("status_message", out.StatusMessage)
+
+	return nil
+}
+
+func resourceLicenseConversionTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// License conversion tasks cannot be deleted or reverted; the resource is only removed from state.
+	log.Printf("[WARN] License Manager License Conversion Task (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func FindLicenseConversionTaskByID(ctx context.Context, conn *licensemanager.LicenseManager, id string) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	in := &licensemanager.GetLicenseConversionTaskInput{
+		LicenseConversionTaskId: aws.String(id),
+	}
+
+	out, err := conn.GetLicenseConversionTaskWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusLicenseConversionTask(ctx context.Context, conn *licensemanager.LicenseManager, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLicenseConversionTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitLicenseConversionTaskSucceeded(ctx context.Context, conn *licensemanager.LicenseManager, id string, timeout time.Duration) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{licensemanager.LicenseConversionTaskStatusInProgress},
+		Target:     []string{licensemanager.LicenseConversionTaskStatusSucceeded},
+		Refresh:    statusLicenseConversionTask(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.GetLicenseConversionTaskOutput); ok {
+		if v := aws.StringValue(output.StatusMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLicenseConversionContext(tfList []interface{}) *licensemanager.LicenseConversionContext {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &licensemanager.LicenseConversionContext{}
+
+	if v, ok := tfMap["usage_operation"].(string); ok && v != "" {
+		apiObject.UsageOperation = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLicenseConversionContext(apiObject *licensemanager.LicenseConversionContext) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"usage_operation": aws.StringValue(apiObject.UsageOperation),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,88 @@
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+)
+
+const (
+	conversionInstanceARNKey      = "TF_AWS_LICENSE_MANAGER_CONVERSION_INSTANCE_ARN"
+	envVarConversionInstanceError = "ARN of an EC2 instance launched from a BYOL Windows Server AMI to convert to license included."
+)
+
+func TestAccLicenseManagerLicenseConversionTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceARN := envvar.SkipIfEmpty(t, conversionInstanceARNKey, envVarConversionInstanceError)
+	resourceName := "aws_licensemanager_license_conversion_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, licensemanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConversionTaskConfig_basic(instanceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConversionTaskExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.0.usage_operation", "RunInstances:0002"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", instanceARN),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.0.usage_operation", "RunInstances:0800"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", licensemanager.LicenseConversionTaskStatusSucceeded),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseConversionTaskExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No License Manager License Conversion Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn()
+
+		_, err := tflicensemanager.FindLicenseConversionTaskByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLicenseConversionTaskConfig_basic(instanceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_conversion_task" "test" {
+  resource_arn = %[1]q
+
+  source_license_context {
+    usage_operation = "RunInstances:0800"
+  }
+
+  destination_license_context {
+    usage_operation = "RunInstances:0002"
+  }
+}
+`, instanceARN)
+}
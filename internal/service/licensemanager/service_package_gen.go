@@ -58,6 +58,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLicenseConversionTask,
+			TypeName: "aws_licensemanager_license_conversion_task",
+		},
 	}
 }
 
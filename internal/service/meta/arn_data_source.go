@@ -4,9 +4,15 @@ package meta
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -35,32 +41,84 @@ func (d *dataSourceARN) Schema(ctx context.Context, req datasource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"account": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(aws|\d{12})?$`), "must be empty, aws or a 12-digit account ID"),
+				},
 			},
 			"arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
-				Required:   true,
+				Optional:   true,
+				Computed:   true,
 			},
 			"id": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
 			"partition": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^aws(-[a-z]+)*$`), "must be a partition identifier, e.g. aws or aws-cn"),
+				},
 			},
 			"region": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([a-z]{2}(-[a-z]+)+-\d+)?$`), "must be empty or a Region name, e.g. us-west-2"),
+				},
 			},
 			"resource": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"service": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9-]+$`), "must be a service namespace, e.g. s3 or rds"),
+				},
 			},
 		},
 	}
 }
 
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dataSourceARN) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		// Either parse an ARN or build one from its parts.
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("arn"),
+			path.MatchRoot("resource"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("resource"),
+			path.MatchRoot("service"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("arn"),
+			path.MatchRoot("account"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("arn"),
+			path.MatchRoot("partition"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("arn"),
+			path.MatchRoot("region"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("arn"),
+			path.MatchRoot("service"),
+		),
+	}
+}
+
 // Read is called when the provider must read data source values in order to update state.
 // Config values should be read from the ReadRequest and new state values set on the ReadResponse.
 func (d *dataSourceARN) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -72,14 +130,29 @@ func (d *dataSourceARN) Read(ctx context.Context, request datasource.ReadRequest
 		return
 	}
 
-	arn := data.ARN.ValueARN()
+	if data.ARN.IsNull() {
+		partition := d.Meta().Partition
+		if !data.Partition.IsNull() {
+			partition = data.Partition.ValueString()
+		}
+
+		data.ARN = fwtypes.ARNValue(arn.ARN{
+			AccountID: data.Account.ValueString(),
+			Partition: partition,
+			Region:    data.Region.ValueString(),
+			Resource:  data.Resource.ValueString(),
+			Service:   data.Service.ValueString(),
+		})
+	}
+
+	v := data.ARN.ValueARN()
 
-	data.Account = types.StringValue(arn.AccountID)
-	data.ID = types.StringValue(arn.String())
-	data.Partition = types.StringValue(arn.Partition)
-	data.Region = types.StringValue(arn.Region)
-	data.Resource = types.StringValue(arn.Resource)
-	data.Service = types.StringValue(arn.Service)
+	data.Account = types.StringValue(v.AccountID)
+	data.ID = types.StringValue(v.String())
+	data.Partition = types.StringValue(v.Partition)
+	data.Region = types.StringValue(v.Region)
+	data.Resource = types.StringValue(v.Resource)
+	data.Service = types.StringValue(v.Service)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
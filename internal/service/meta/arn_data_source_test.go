@@ -2,6 +2,7 @@ package meta_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -59,6 +60,78 @@ func TestAccMetaARNDataSource_s3Bucket(t *testing.T) {
 	})
 }
 
+func TestAccMetaARNDataSource_build(t *testing.T) {
+	ctx := acctest.Context(t)
+	arn := "arn:aws:rds:eu-west-1:123456789012:db:mysql-db" // lintignore:AWSAT003,AWSAT005
+	dataSourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig_build,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account", "123456789012"),
+					resource.TestCheckResourceAttr(dataSourceName, "arn", arn),
+					resource.TestCheckResourceAttr(dataSourceName, "id", arn),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", "eu-west-1"), // lintignore:AWSAT003
+					resource.TestCheckResourceAttr(dataSourceName, "resource", "db:mysql-db"),
+					resource.TestCheckResourceAttr(dataSourceName, "service", "rds"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNDataSource_buildDefaultPartition(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig_buildDefaultPartition,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "arn", fmt.Sprintf("arn:%s:s3:::my_corporate_bucket/Development/*", acctest.Partition())),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "region", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNDataSource_buildInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccARNDataSourceConfig_buildConflicting,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccARNDataSourceConfig_buildWithoutService,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccARNDataSourceConfig_buildInvalidAccount,
+				ExpectError: regexp.MustCompile(`must be empty, aws or a 12-digit account ID`),
+			},
+		},
+	})
+}
+
 func testAccARNDataSourceConfig_basic(arn string) string {
 	return fmt.Sprintf(`
 data "aws_arn" "test" {
@@ -66,3 +139,42 @@ data "aws_arn" "test" {
 }
 `, arn)
 }
+
+const testAccARNDataSourceConfig_build = `
+data "aws_arn" "test" {
+  partition = "aws"
+  service   = "rds"
+  region    = "eu-west-1"
+  account   = "123456789012"
+  resource  = "db:mysql-db"
+}
+`
+
+const testAccARNDataSourceConfig_buildDefaultPartition = `
+data "aws_arn" "test" {
+  service  = "s3"
+  resource = "my_corporate_bucket/Development/*"
+}
+`
+
+const testAccARNDataSourceConfig_buildConflicting = `
+data "aws_arn" "test" {
+  arn      = "arn:aws:rds:eu-west-1:123456789012:db:mysql-db"
+  service  = "rds"
+  resource = "db:mysql-db"
+}
+`
+
+const testAccARNDataSourceConfig_buildWithoutService = `
+data "aws_arn" "test" {
+  resource = "db:mysql-db"
+}
+`
+
+const testAccARNDataSourceConfig_buildInvalidAccount = `
+data "aws_arn" "test" {
+  service  = "rds"
+  account  = "1234"
+  resource = "db:mysql-db"
+}
+`
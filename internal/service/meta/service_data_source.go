@@ -39,6 +39,9 @@ func (d *dataSourceService) Schema(ctx context.Context, req datasource.SchemaReq
 				Optional: true,
 				Computed: true,
 			},
+			"endpoint_hostname": schema.StringAttribute{
+				Computed: true,
+			},
 			"id": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -62,6 +65,12 @@ func (d *dataSourceService) Schema(ctx context.Context, req datasource.SchemaReq
 				Optional: true,
 				Computed: true,
 			},
+			"signing_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"signing_region": schema.StringAttribute{
+				Computed: true,
+			},
 			"supported": schema.BoolAttribute{
 				Computed: true,
 			},
@@ -131,12 +140,20 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 	data.ReverseDNSName = types.StringValue(reverseDNSName)
 	data.DNSName = types.StringValue(strings.ToLower(strings.Join(slices.Reverse(strings.Split(reverseDNSName, ".")), ".")))
 
+	data.EndpointHostname = types.StringNull()
+	data.SigningName = types.StringNull()
+	data.SigningRegion = types.StringNull()
 	data.Supported = types.BoolValue(true)
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), data.Region.ValueString()); ok {
 		data.Partition = types.StringValue(partition.ID())
 
 		if _, ok := partition.Services()[data.ServiceID.ValueString()]; !ok {
 			data.Supported = types.BoolValue(false)
+		} else if endpoint, err := partition.EndpointFor(data.ServiceID.ValueString(), data.Region.ValueString()); err == nil {
+			// The resolved endpoint accounts for partition DNS suffixes and per-service hostname exceptions (e.g. global services).
+			data.EndpointHostname = types.StringValue(strings.TrimPrefix(endpoint.URL, "https://"))
+			data.SigningName = types.StringValue(endpoint.SigningName)
+			data.SigningRegion = types.StringValue(endpoint.SigningRegion)
 		}
 	} else {
 		data.Partition = types.StringNull()
@@ -149,11 +166,14 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 
 type dataSourceServiceData struct {
 	DNSName          types.String `tfsdk:"dns_name"`
+	EndpointHostname types.String `tfsdk:"endpoint_hostname"`
 	ID               types.String `tfsdk:"id"`
 	Partition        types.String `tfsdk:"partition"`
 	Region           types.String `tfsdk:"region"`
 	ReverseDNSName   types.String `tfsdk:"reverse_dns_name"`
 	ReverseDNSPrefix types.String `tfsdk:"reverse_dns_prefix"`
 	ServiceID        types.String `tfsdk:"service_id"`
+	SigningName      types.String `tfsdk:"signing_name"`
+	SigningRegion    types.String `tfsdk:"signing_region"`
 	Supported        types.Bool   `tfsdk:"supported"`
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/waf"
@@ -27,11 +28,14 @@ func TestAccMetaService_basic(t *testing.T) {
 				Config: testAccServiceDataSourceConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "dns_name", fmt.Sprintf("%s.%s.%s", ec2.EndpointsID, acctest.Region(), "amazonaws.com")),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_hostname", fmt.Sprintf("%s.%s.%s", ec2.EndpointsID, acctest.Region(), acctest.PartitionDNSSuffix())),
 					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
 					resource.TestCheckResourceAttr(dataSourceName, "reverse_dns_prefix", "com.amazonaws"),
 					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
 					resource.TestCheckResourceAttr(dataSourceName, "reverse_dns_name", fmt.Sprintf("%s.%s.%s", "com.amazonaws", acctest.Region(), ec2.EndpointsID)),
 					resource.TestCheckResourceAttr(dataSourceName, "service_id", ec2.EndpointsID),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_name", ec2.EndpointsID),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_region", acctest.Region()),
 					resource.TestCheckResourceAttr(dataSourceName, "supported", "true"),
 				),
 			},
@@ -51,6 +55,7 @@ func TestAccMetaService_byReverseDNSName(t *testing.T) {
 			{
 				Config: testAccServiceDataSourceConfig_byReverseDNSName(),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_hostname", fmt.Sprintf("%s.%s.%s", s3.EndpointsID, endpoints.CnNorth1RegionID, "amazonaws.com.cn")),
 					resource.TestCheckResourceAttr(dataSourceName, "region", endpoints.CnNorth1RegionID),
 					resource.TestCheckResourceAttr(dataSourceName, "reverse_dns_name", fmt.Sprintf("%s.%s.%s", "cn.com.amazonaws", endpoints.CnNorth1RegionID, s3.EndpointsID)),
 					resource.TestCheckResourceAttr(dataSourceName, "reverse_dns_prefix", "cn.com.amazonaws"),
@@ -119,6 +124,7 @@ func TestAccMetaService_unsupported(t *testing.T) {
 				Config: testAccServiceDataSourceConfig_unsupported(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "dns_name", fmt.Sprintf("%s.%s.%s", waf.EndpointsID, endpoints.UsGovWest1RegionID, "amazonaws.com")),
+					resource.TestCheckNoResourceAttr(dataSourceName, "endpoint_hostname"),
 					resource.TestCheckResourceAttr(dataSourceName, "partition", endpoints.AwsUsGovPartitionID),
 					resource.TestCheckResourceAttr(dataSourceName, "reverse_dns_prefix", "com.amazonaws"),
 					resource.TestCheckResourceAttr(dataSourceName, "region", endpoints.UsGovWest1RegionID),
@@ -131,6 +137,29 @@ func TestAccMetaService_unsupported(t *testing.T) {
 	})
 }
 
+func TestAccMetaService_globalEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDataSourceConfig_globalEndpoint(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dns_name", fmt.Sprintf("%s.%s.%s", iam.EndpointsID, endpoints.UsWest2RegionID, "amazonaws.com")),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_hostname", fmt.Sprintf("%s.%s", iam.EndpointsID, "amazonaws.com")),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_name", iam.EndpointsID),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_region", endpoints.UsEast1RegionID),
+					resource.TestCheckResourceAttr(dataSourceName, "supported", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccServiceDataSourceConfig_basic() string {
 	return fmt.Sprintf(`
 data "aws_service" "test" {
@@ -177,3 +206,12 @@ data "aws_service" "test" {
 }
 `
 }
+
+func testAccServiceDataSourceConfig_globalEndpoint() string {
+	// lintignore:AWSAT003
+	return `
+data "aws_service" "test" {
+  reverse_dns_name = "com.amazonaws.us-west-2.iam"
+}
+`
+}
@@ -36,6 +36,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceService,
 		},
+		{
+			Factory: newDataSourceServicePrincipal,
+		},
 	}
 }
 
@@ -0,0 +1,123 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource
+func newDataSourceServicePrincipal(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceServicePrincipal{}
+
+	return d, nil
+}
+
+type dataSourceServicePrincipal struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceServicePrincipal) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_service_principal"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceServicePrincipal) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"service_name": schema.StringAttribute{
+				Required: true,
+			},
+			"suffix": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceServicePrincipal) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceServicePrincipalData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := d.Meta().Region
+	if !data.Region.IsNull() {
+		name = data.Region.ValueString()
+	}
+
+	region, err := FindRegionByName(name)
+
+	if err != nil {
+		response.Diagnostics.AddError("finding Region by name", err.Error())
+
+		return
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region.ID())
+
+	if !ok {
+		response.Diagnostics.AddError("finding Partition by Region", fmt.Sprintf("partition not found for Region %q", region.ID()))
+
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+	suffix := ServicePrincipalSuffix(serviceName, partition.ID())
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", serviceName, region.ID()))
+	data.Name = types.StringValue(fmt.Sprintf("%s.%s", serviceName, suffix))
+	data.Region = types.StringValue(region.ID())
+	data.Suffix = types.StringValue(suffix)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceServicePrincipalData struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Region      types.String `tfsdk:"region"`
+	ServiceName types.String `tfsdk:"service_name"`
+	Suffix      types.String `tfsdk:"suffix"`
+}
+
+// ServicePrincipalSuffix returns the domain suffix of the specified service's principal in the specified partition.
+// Service principals do not follow the partition's DNS suffix: in AWS China most services keep amazonaws.com,
+// while a few use amazonaws.com.cn.
+func ServicePrincipalSuffix(serviceName, partition string) string {
+	switch partition {
+	case endpoints.AwsCnPartitionID:
+		switch serviceName {
+		case "codedeploy", "elasticmapreduce", "logs":
+			return "amazonaws.com.cn"
+		}
+	case endpoints.AwsIsoPartitionID:
+		return "c2s.ic.gov"
+	case endpoints.AwsIsoBPartitionID:
+		return "sc2s.sgov.gov"
+	}
+
+	return "amazonaws.com"
+}
@@ -0,0 +1,127 @@
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestServicePrincipalSuffix(t *testing.T) {
+	t.Parallel()
+
+	var testCases = []struct {
+		ServiceName string
+		Partition   string
+		Expected    string
+	}{
+		{
+			ServiceName: "logs",
+			Partition:   endpoints.AwsPartitionID,
+			Expected:    "amazonaws.com",
+		},
+		{
+			ServiceName: "logs",
+			Partition:   endpoints.AwsCnPartitionID,
+			Expected:    "amazonaws.com.cn",
+		},
+		{
+			ServiceName: "ec2",
+			Partition:   endpoints.AwsCnPartitionID,
+			Expected:    "amazonaws.com",
+		},
+		{
+			ServiceName: "logs",
+			Partition:   endpoints.AwsUsGovPartitionID,
+			Expected:    "amazonaws.com",
+		},
+		{
+			ServiceName: "ec2",
+			Partition:   endpoints.AwsIsoPartitionID,
+			Expected:    "c2s.ic.gov",
+		},
+		{
+			ServiceName: "ec2",
+			Partition:   endpoints.AwsIsoBPartitionID,
+			Expected:    "sc2s.sgov.gov",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tfmeta.ServicePrincipalSuffix(tc.ServiceName, tc.Partition); got != tc.Expected {
+			t.Errorf("ServicePrincipalSuffix(%q, %q) = %q, expected %q", tc.ServiceName, tc.Partition, got, tc.Expected)
+		}
+	}
+}
+
+func TestAccMetaServicePrincipalDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_basic("s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("s3.%s", acctest.Region())),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("s3.%s", tfmeta.ServicePrincipalSuffix("s3", acctest.Partition()))),
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "service_name", "s3"),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", tfmeta.ServicePrincipalSuffix("s3", acctest.Partition())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaServicePrincipalDataSource_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_region("logs", "cn-north-1"), // lintignore:AWSAT003
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "logs.cn-north-1"),         // lintignore:AWSAT003
+					resource.TestCheckResourceAttr(dataSourceName, "name", "logs.amazonaws.com.cn"), // lintignore:AWSAT003
+					resource.TestCheckResourceAttr(dataSourceName, "region", "cn-north-1"),          // lintignore:AWSAT003
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com.cn"),    // lintignore:AWSAT003
+				),
+			},
+			{
+				Config: testAccServicePrincipalDataSourceConfig_region("ec2", "cn-north-1"), // lintignore:AWSAT003
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "ec2.amazonaws.com"), // lintignore:AWSAT003
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com"),   // lintignore:AWSAT003
+				),
+			},
+		},
+	})
+}
+
+func testAccServicePrincipalDataSourceConfig_basic(serviceName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+}
+`, serviceName)
+}
+
+func testAccServicePrincipalDataSourceConfig_region(serviceName, region string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+  region       = %[2]q
+}
+`, serviceName, region)
+}
@@ -0,0 +1,294 @@
+package mgn
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 600),
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"progress_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wave_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	name := d.Get("name").(string)
+	input := &mgn.CreateApplicationInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MGN Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationID))
+
+	if v, ok := d.GetOk("wave_id"); ok {
+		if err := associateApplicationWithWave(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	output, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MGN Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if v := output.ApplicationAggregatedStatus; v != nil {
+		d.Set("health_status", v.HealthStatus)
+		d.Set("progress_status", v.ProgressStatus)
+	} else {
+		d.Set("health_status", nil)
+		d.Set("progress_status", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("wave_id", output.WaveID)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChanges("description", "name") {
+		input := &mgn.UpdateApplicationInput{
+			ApplicationID: aws.String(d.Id()),
+			Description:   aws.String(d.Get("description").(string)),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MGN Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("wave_id") {
+		o, n := d.GetChange("wave_id")
+
+		if o := o.(string); o != "" {
+			if err := disassociateApplicationFromWave(ctx, conn, d.Id(), o); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if n := n.(string); n != "" {
+			if err := associateApplicationWithWave(ctx, conn, d.Id(), n); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	// Only archived applications can be deleted.
+	output, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MGN Application (%s): %s", d.Id(), err)
+	}
+
+	if v := aws.StringValue(output.WaveID); v != "" {
+		if err := disassociateApplicationFromWave(ctx, conn, d.Id(), v); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if !aws.BoolValue(output.IsArchived) {
+		_, err := conn.ArchiveApplicationWithContext(ctx, &mgn.ArchiveApplicationInput{
+			ApplicationID: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "archiving MGN Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MGN Application: %s", d.Id())
+	_, err = conn.DeleteApplicationWithContext(ctx, &mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MGN Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func associateApplicationWithWave(ctx context.Context, conn *mgn.Mgn, applicationID, waveID string) error {
+	_, err := conn.AssociateApplicationsWithContext(ctx, &mgn.AssociateApplicationsInput{
+		ApplicationIDs: aws.StringSlice([]string{applicationID}),
+		WaveID:         aws.String(waveID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("associating MGN Application (%s) with Wave (%s): %w", applicationID, waveID, err)
+	}
+
+	return nil
+}
+
+func disassociateApplicationFromWave(ctx context.Context, conn *mgn.Mgn, applicationID, waveID string) error {
+	_, err := conn.DisassociateApplicationsWithContext(ctx, &mgn.DisassociateApplicationsInput{
+		ApplicationIDs: aws.StringSlice([]string{applicationID}),
+		WaveID:         aws.String(waveID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disassociating MGN Application (%s) from Wave (%s): %w", applicationID, waveID, err)
+	}
+
+	return nil
+}
+
+func FindApplicationByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.Application, error) {
+	input := &mgn.ListApplicationsInput{
+		Filters: &mgn.ListApplicationsRequestFilters{
+			ApplicationIDs: aws.StringSlice([]string{id}),
+		},
+	}
+
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findApplications(ctx context.Context, conn *mgn.Mgn, input *mgn.ListApplicationsInput) ([]*mgn.Application, error) {
+	var output []*mgn.Application
+
+	err := conn.ListApplicationsPagesWithContext(ctx, input, func(page *mgn.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,476 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mgn_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+func ResourceLaunchConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceLaunchConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceLaunchConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceLaunchConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"boot_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.BootMode_Values(), false),
+			},
+			"copy_private_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ec2_launch_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_map_auto_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"large_volume_conf": launchTemplateDiskConfSchema(),
+			"launch_disposition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.LaunchDisposition_Values(), false),
+			},
+			"licensing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_byol": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"map_auto_tagging_mpe_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"small_volume_conf": launchTemplateDiskConfSchema(),
+			"small_volume_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_instance_type_right_sizing_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.TargetInstanceTypeRightSizingMethod_Values(), false),
+			},
+		},
+	}
+}
+
+func launchTemplateDiskConfSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"iops": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"throughput": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"volume_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mgn.VolumeType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceLaunchConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	input := &mgn.CreateLaunchConfigurationTemplateInput{
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOkExists("associate_public_ip_address"); ok {
+		input.AssociatePublicIpAddress = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("boot_mode"); ok {
+		input.BootMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("copy_private_ip"); ok {
+		input.CopyPrivateIp = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("enable_map_auto_tagging"); ok {
+		input.EnableMapAutoTagging = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("large_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LargeVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("launch_disposition"); ok {
+		input.LaunchDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("map_auto_tagging_mpe_id"); ok {
+		input.MapAutoTaggingMpeID = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("small_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SmallVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("small_volume_max_size"); ok {
+		input.SmallVolumeMaxSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("target_instance_type_right_sizing_method"); ok {
+		input.TargetInstanceTypeRightSizingMethod = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLaunchConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MGN Launch Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LaunchConfigurationTemplateID))
+
+	return append(diags, resourceLaunchConfigurationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceLaunchConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	output, err := FindLaunchConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Launch Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MGN Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("associate_public_ip_address", output.AssociatePublicIpAddress)
+	d.Set("boot_mode", output.BootMode)
+	d.Set("copy_private_ip", output.CopyPrivateIp)
+	d.Set("copy_tags", output.CopyTags)
+	d.Set("ec2_launch_template_id", output.Ec2LaunchTemplateID)
+	d.Set("enable_map_auto_tagging", output.EnableMapAutoTagging)
+	if output.LargeVolumeConf != nil {
+		if err := d.Set("large_volume_conf", []interface{}{flattenLaunchTemplateDiskConf(output.LargeVolumeConf)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting large_volume_conf: %s", err)
+		}
+	} else {
+		d.Set("large_volume_conf", nil)
+	}
+	d.Set("launch_disposition", output.LaunchDisposition)
+	if output.Licensing != nil {
+		if err := d.Set("licensing", []interface{}{flattenLicensing(output.Licensing)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting licensing: %s", err)
+		}
+	} else {
+		d.Set("licensing", nil)
+	}
+	d.Set("map_auto_tagging_mpe_id", output.MapAutoTaggingMpeID)
+	if output.SmallVolumeConf != nil {
+		if err := d.Set("small_volume_conf", []interface{}{flattenLaunchTemplateDiskConf(output.SmallVolumeConf)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting small_volume_conf: %s", err)
+		}
+	} else {
+		d.Set("small_volume_conf", nil)
+	}
+	d.Set("small_volume_max_size", output.SmallVolumeMaxSize)
+	d.Set("target_instance_type_right_sizing_method", output.TargetInstanceTypeRightSizingMethod)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceLaunchConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mgn.UpdateLaunchConfigurationTemplateInput{
+			LaunchConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("associate_public_ip_address") {
+			input.AssociatePublicIpAddress = aws.Bool(d.Get("associate_public_ip_address").(bool))
+		}
+
+		if d.HasChange("boot_mode") {
+			input.BootMode = aws.String(d.Get("boot_mode").(string))
+		}
+
+		if d.HasChange("copy_private_ip") {
+			input.CopyPrivateIp = aws.Bool(d.Get("copy_private_ip").(bool))
+		}
+
+		if d.HasChange("copy_tags") {
+			input.CopyTags = aws.Bool(d.Get("copy_tags").(bool))
+		}
+
+		if d.HasChange("enable_map_auto_tagging") {
+			input.EnableMapAutoTagging = aws.Bool(d.Get("enable_map_auto_tagging").(bool))
+		}
+
+		if d.HasChange("large_volume_conf") {
+			if v, ok := d.GetOk("large_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LargeVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("launch_disposition") {
+			input.LaunchDisposition = aws.String(d.Get("launch_disposition").(string))
+		}
+
+		if d.HasChange("licensing") {
+			if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("map_auto_tagging_mpe_id") {
+			input.MapAutoTaggingMpeID = aws.String(d.Get("map_auto_tagging_mpe_id").(string))
+		}
+
+		if d.HasChange("small_volume_conf") {
+			if v, ok := d.GetOk("small_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SmallVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("small_volume_max_size") {
+			input.SmallVolumeMaxSize = aws.Int64(int64(d.Get("small_volume_max_size").(int)))
+		}
+
+		if d.HasChange("target_instance_type_right_sizing_method") {
+			input.TargetInstanceTypeRightSizingMethod = aws.String(d.Get("target_instance_type_right_sizing_method").(string))
+		}
+
+		_, err := conn.UpdateLaunchConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MGN Launch Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLaunchConfigurationTemplateRead(ctx, d, meta)...)
+}
+
+func resourceLaunchConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[DEBUG] Deleting MGN Launch Configuration Template: %s", d.Id())
+	_, err := conn.DeleteLaunchConfigurationTemplateWithContext(ctx, &mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MGN Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.LaunchConfigurationTemplate, error) {
+	input := &mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *mgn.Mgn, input *mgn.DescribeLaunchConfigurationTemplatesInput) ([]*mgn.LaunchConfigurationTemplate, error) {
+	var output []*mgn.LaunchConfigurationTemplate
+
+	err := conn.DescribeLaunchConfigurationTemplatesPagesWithContext(ctx, input, func(page *mgn.DescribeLaunchConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandLaunchTemplateDiskConf(tfMap map[string]interface{}) *mgn.LaunchTemplateDiskConf {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mgn.LaunchTemplateDiskConf{}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLicensing(tfMap map[string]interface{}) *mgn.Licensing {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mgn.Licensing{}
+
+	if v, ok := tfMap["os_byol"].(bool); ok {
+		apiObject.OsByol = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLaunchTemplateDiskConf(apiObject *mgn.LaunchTemplateDiskConf) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Iops; v != nil {
+		tfMap["iops"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Throughput; v != nil {
+		tfMap["throughput"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.VolumeType; v != nil {
+		tfMap["volume_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLicensing(apiObject *mgn.Licensing) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OsByol; v != nil {
+		tfMap["os_byol"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,230 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mgn_wave", name="Wave")
+// @Tags(identifierAttribute="arn")
+func ResourceWave() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWaveCreate,
+		ReadWithoutTimeout:   resourceWaveRead,
+		UpdateWithoutTimeout: resourceWaveUpdate,
+		DeleteWithoutTimeout: resourceWaveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 600),
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"progress_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceWaveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	name := d.Get("name").(string)
+	input := &mgn.CreateWaveInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWaveWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MGN Wave (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WaveID))
+
+	return append(diags, resourceWaveRead(ctx, d, meta)...)
+}
+
+func resourceWaveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	output, err := FindWaveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Wave (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MGN Wave (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if v := output.WaveAggregatedStatus; v != nil {
+		d.Set("health_status", v.HealthStatus)
+		d.Set("progress_status", v.ProgressStatus)
+	} else {
+		d.Set("health_status", nil)
+		d.Set("progress_status", nil)
+	}
+	d.Set("name", output.Name)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceWaveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChanges("description", "name") {
+		input := &mgn.UpdateWaveInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Get("name").(string)),
+			WaveID:      aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateWaveWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MGN Wave (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWaveRead(ctx, d, meta)...)
+}
+
+func resourceWaveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	// Only archived waves can be deleted.
+	output, err := FindWaveByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MGN Wave (%s): %s", d.Id(), err)
+	}
+
+	if !aws.BoolValue(output.IsArchived) {
+		_, err := conn.ArchiveWaveWithContext(ctx, &mgn.ArchiveWaveInput{
+			WaveID: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "archiving MGN Wave (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MGN Wave: %s", d.Id())
+	_, err = conn.DeleteWaveWithContext(ctx, &mgn.DeleteWaveInput{
+		WaveID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MGN Wave (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWaveByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.Wave, error) {
+	input := &mgn.ListWavesInput{
+		Filters: &mgn.ListWavesRequestFilters{
+			WaveIDs: aws.StringSlice([]string{id}),
+		},
+	}
+
+	output, err := findWaves(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findWaves(ctx context.Context, conn *mgn.Mgn, input *mgn.ListWavesInput) ([]*mgn.Wave, error) {
+	var output []*mgn.Wave
+
+	err := conn.ListWavesPagesWithContext(ctx, input, func(page *mgn.ListWavesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,9 @@
+package osis
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
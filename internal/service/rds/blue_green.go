@@ -60,10 +60,14 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+// switchover switches over the Blue/Green Deployment. A zero switchoverTimeout (seconds) uses the RDS default.
+func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, switchoverTimeout int, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(int32(switchoverTimeout))
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -2,6 +2,7 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -198,9 +199,10 @@ func ResourceClusterInstance() *schema.Resource {
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
 			"promotion_tier": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 15),
 			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
@@ -421,7 +423,13 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChange("promotion_tier") {
+		if err := modifyClusterInstancePromotionTier(ctx, conn, d.Get("cluster_identifier").(string), d.Id(), d.Get("promotion_tier").(int), d.Get("apply_immediately").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Instance (%s) promotion tier: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("promotion_tier", "tags", "tags_all") {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -475,10 +483,6 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
 		}
 
-		if d.HasChange("promotion_tier") {
-			input.PromotionTier = aws.Int64(int64(d.Get("promotion_tier").(int)))
-		}
-
 		if d.HasChange("publicly_accessible") {
 			input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
 		}
@@ -501,6 +505,41 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	return append(diags, resourceClusterInstanceRead(ctx, d, meta)...)
 }
 
+// modifyClusterInstancePromotionTier changes a cluster instance's promotion tier on its own, apart from any other modifications.
+// Changes to the promotion tiers of the instances in a cluster are made one at a time, and each change that is applied immediately
+// waits until the cluster reports the new tier, so that concurrent updates never leave the cluster in a transient state that a failover would observe.
+func modifyClusterInstancePromotionTier(ctx context.Context, conn *rds.RDS, clusterID, id string, promotionTier int, applyImmediately bool, timeout time.Duration) error {
+	mutexKey := "aws_rds_cluster_instance-promotion_tier-" + clusterID
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	input := &rds.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(applyImmediately),
+		DBInstanceIdentifier: aws.String(id),
+		PromotionTier:        aws.Int64(int64(promotionTier)),
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster Instance: %s", input)
+	if _, err := conn.ModifyDBInstanceWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitDBClusterInstanceUpdated(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	// Otherwise the change is pending until the next maintenance window.
+	if !applyImmediately {
+		return nil
+	}
+
+	if err := waitDBClusterMemberPromotionTierUpdated(ctx, conn, clusterID, id, promotionTier, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) member promotion tier update: %w", clusterID, err)
+	}
+
+	return nil
+}
+
 func resourceClusterInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn()
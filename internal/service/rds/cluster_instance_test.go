@@ -233,6 +233,45 @@ func TestAccRDSClusterInstance_az(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterInstance_promotionTierSwap(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_rds_cluster_instance.test.0"
+	resourceName2 := "aws_rds_cluster_instance.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_promotionTiers(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "0"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "1"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_promotionTiers(rName, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSClusterInstance_identifierPrefix(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1238,6 +1277,21 @@ resource "aws_db_parameter_group" "test" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_promotionTiers(rName string, promotionTier1, promotionTier2 int) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+  promotion_tier     = count.index == 0 ? %[2]d : %[3]d
+  apply_immediately  = true
+}
+`, rName, promotionTier1, promotionTier2))
+}
+
 func testAccClusterInstanceConfig_identifierPrefix(rName, identifierPrefix string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigVPCWithSubnets(rName, 2),
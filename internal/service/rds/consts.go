@@ -109,6 +109,25 @@ const (
 	EventSubscriptionStatusModifying = "modifying"
 )
 
+const (
+	CustomDBEngineVersionStatusAvailable                      = "available"
+	CustomDBEngineVersionStatusCreating                       = "creating"
+	CustomDBEngineVersionStatusDeleting                       = "deleting"
+	CustomDBEngineVersionStatusFailed                         = "failed"
+	CustomDBEngineVersionStatusInactive                       = "inactive"
+	CustomDBEngineVersionStatusInactiveExceptRestore          = "inactive-except-restore"
+	CustomDBEngineVersionStatusIncompatibleImageConfiguration = "incompatible-image-configuration"
+	CustomDBEngineVersionStatusPendingValidation              = "pending-validation"
+)
+
+func CustomDBEngineVersionStatus_Values() []string {
+	return []string{
+		CustomDBEngineVersionStatusAvailable,
+		CustomDBEngineVersionStatusInactive,
+		CustomDBEngineVersionStatusInactiveExceptRestore,
+	}
+}
+
 const (
 	DBSnapshotAvailable = "available"
 	DBSnapshotCreating  = "creating"
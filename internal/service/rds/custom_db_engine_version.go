@@ -0,0 +1,323 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_custom_db_engine_version", name="Custom DB Engine Version")
+// @Tags(identifierAttribute="arn")
+func ResourceCustomDBEngineVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomDBEngineVersionCreate,
+		ReadWithoutTimeout:   resourceCustomDBEngineVersionRead,
+		UpdateWithoutTimeout: resourceCustomDBEngineVersionUpdate,
+		DeleteWithoutTimeout: resourceCustomDBEngineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_installation_files_s3_bucket_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"database_installation_files_s3_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"db_parameter_group_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 35),
+					validation.StringMatch(regexp.MustCompile(`^custom-(oracle|sqlserver)-`), "must be an RDS Custom for Oracle or SQL Server engine"),
+				),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"manifest_computed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(CustomDBEngineVersionStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	engine := d.Get("engine").(string)
+	engineVersion := d.Get("engine_version").(string)
+	id := CustomDBEngineVersionCreateResourceID(engine, engineVersion)
+	input := &rds.CreateCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		Tags:          GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_bucket_name"); ok {
+		input.DatabaseInstallationFilesS3BucketName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_prefix"); ok {
+		input.DatabaseInstallationFilesS3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok {
+		input.Manifest = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateCustomDBEngineVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Custom DB Engine Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitCustomDBEngineVersionCreated(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != CustomDBEngineVersionStatusAvailable {
+		if err := modifyCustomDBEngineVersion(ctx, conn, engine, engineVersion, &rds.ModifyCustomDBEngineVersionInput{
+			Status: aws.String(v.(string)),
+		}, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Custom DB Engine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DBEngineVersionArn)
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	}
+	d.Set("database_installation_files_s3_bucket_name", output.DatabaseInstallationFilesS3BucketName)
+	d.Set("database_installation_files_s3_prefix", output.DatabaseInstallationFilesS3Prefix)
+	d.Set("db_parameter_group_family", output.DBParameterGroupFamily)
+	d.Set("description", output.DBEngineVersionDescription)
+	d.Set("engine", output.Engine)
+	d.Set("engine_version", output.EngineVersion)
+	if output.Image != nil {
+		d.Set("image_id", output.Image.ImageId)
+	} else {
+		d.Set("image_id", nil)
+	}
+	d.Set("kms_key_id", output.KMSKeyId)
+	d.Set("major_engine_version", output.MajorEngineVersion)
+	d.Set("manifest_computed", output.CustomDBEngineVersionManifest)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceCustomDBEngineVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	if d.HasChanges("description", "status") {
+		engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &rds.ModifyCustomDBEngineVersionInput{}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if err := modifyCustomDBEngineVersion(ctx, conn, engine, engineVersion, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Custom DB Engine Version: %s", d.Id())
+	_, err = conn.DeleteCustomDBEngineVersionWithContext(ctx, &rds.DeleteCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCustomDBEngineVersionDeleted(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func modifyCustomDBEngineVersion(ctx context.Context, conn *rds.RDS, engine, engineVersion string, input *rds.ModifyCustomDBEngineVersionInput, timeout time.Duration) error {
+	input.Engine = aws.String(engine)
+	input.EngineVersion = aws.String(engineVersion)
+
+	output, err := conn.ModifyCustomDBEngineVersionWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	status := aws.StringValue(input.Status)
+	if status == "" {
+		status = aws.StringValue(output.Status)
+	}
+
+	if _, err := waitCustomDBEngineVersionUpdated(ctx, conn, engine, engineVersion, status, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
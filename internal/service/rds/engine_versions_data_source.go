@@ -0,0 +1,343 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+)
+
+// @SDKDataSource("aws_rds_engine_versions")
+func DataSourceEngineVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEngineVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"engine_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameter_group_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred_major_version_upgrade_target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred_minor_version_upgrade_target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_feature_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"supported_modes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"supports_babelfish": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_global_databases": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_log_exports_to_cloudwatch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_parallel_query": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_read_replica": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"valid_upgrade_targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_upgrade": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"is_major_version_upgrade": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"supported_modes": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"supports_babelfish": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"supports_global_databases": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"supports_parallel_query": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": namevaluesfilters.Schema(),
+			"include_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"parameter_group_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEngineVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	engine := d.Get("engine").(string)
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine: aws.String(engine),
+	}
+
+	if v, ok := d.GetOk("default_only"); ok {
+		input.DefaultOnly = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+	}
+
+	if v, ok := d.GetOk("include_all"); ok {
+		input.IncludeAll = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("parameter_group_family"); ok {
+		input.DBParameterGroupFamily = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	engineVersions, err := findDBEngineVersions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Engine Versions: %s", err)
+	}
+
+	var versions []string
+	for _, v := range engineVersions {
+		versions = append(versions, aws.StringValue(v.EngineVersion))
+	}
+
+	d.SetId(engine)
+	if err := d.Set("engine_versions", flattenDBEngineVersions(engineVersions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting engine_versions: %s", err)
+	}
+	d.Set("versions", versions)
+
+	return diags
+}
+
+func findDBEngineVersions(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBEngineVersionsInput) ([]*rds.DBEngineVersion, error) {
+	var output []*rds.DBEngineVersion
+
+	err := conn.DescribeDBEngineVersionsPagesWithContext(ctx, input, func(page *rds.DescribeDBEngineVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBEngineVersions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenDBEngineVersions(apiObjects []*rds.DBEngineVersion) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDBEngineVersion(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDBEngineVersion(apiObject *rds.DBEngineVersion) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"engine_description":                 aws.StringValue(apiObject.DBEngineDescription),
+		"parameter_group_family":             aws.StringValue(apiObject.DBParameterGroupFamily),
+		"status":                             aws.StringValue(apiObject.Status),
+		"supported_feature_names":            aws.StringValueSlice(apiObject.SupportedFeatureNames),
+		"supported_modes":                    aws.StringValueSlice(apiObject.SupportedEngineModes),
+		"supports_babelfish":                 aws.BoolValue(apiObject.SupportsBabelfish),
+		"supports_global_databases":          aws.BoolValue(apiObject.SupportsGlobalDatabases),
+		"supports_log_exports_to_cloudwatch": aws.BoolValue(apiObject.SupportsLogExportsToCloudwatchLogs),
+		"supports_parallel_query":            aws.BoolValue(apiObject.SupportsParallelQuery),
+		"supports_read_replica":              aws.BoolValue(apiObject.SupportsReadReplica),
+		"valid_upgrade_targets":              flattenUpgradeTargets(apiObject.ValidUpgradeTarget),
+		"version":                            aws.StringValue(apiObject.EngineVersion),
+		"version_description":                aws.StringValue(apiObject.DBEngineVersionDescription),
+	}
+
+	var majorVersionUpgradeTargets, minorVersionUpgradeTargets []*rds.UpgradeTarget
+	for _, v := range apiObject.ValidUpgradeTarget {
+		if v == nil {
+			continue
+		}
+
+		if aws.BoolValue(v.IsMajorVersionUpgrade) {
+			majorVersionUpgradeTargets = append(majorVersionUpgradeTargets, v)
+		} else {
+			minorVersionUpgradeTargets = append(minorVersionUpgradeTargets, v)
+		}
+	}
+
+	if v := latestUpgradeTarget(majorVersionUpgradeTargets); v != nil {
+		tfMap["preferred_major_version_upgrade_target"] = aws.StringValue(v.EngineVersion)
+	}
+
+	// The minor version upgrade target that RDS applies automatically is preferred, otherwise the latest.
+	for _, v := range minorVersionUpgradeTargets {
+		if aws.BoolValue(v.AutoUpgrade) {
+			tfMap["preferred_minor_version_upgrade_target"] = aws.StringValue(v.EngineVersion)
+			break
+		}
+	}
+	if _, ok := tfMap["preferred_minor_version_upgrade_target"]; !ok {
+		if v := latestUpgradeTarget(minorVersionUpgradeTargets); v != nil {
+			tfMap["preferred_minor_version_upgrade_target"] = aws.StringValue(v.EngineVersion)
+		}
+	}
+
+	return tfMap
+}
+
+func flattenUpgradeTargets(apiObjects []*rds.UpgradeTarget) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"auto_upgrade":              aws.BoolValue(apiObject.AutoUpgrade),
+			"description":               aws.StringValue(apiObject.Description),
+			"is_major_version_upgrade":  aws.BoolValue(apiObject.IsMajorVersionUpgrade),
+			"supported_modes":           aws.StringValueSlice(apiObject.SupportedEngineModes),
+			"supports_babelfish":        aws.BoolValue(apiObject.SupportsBabelfish),
+			"supports_global_databases": aws.BoolValue(apiObject.SupportsGlobalDatabases),
+			"supports_parallel_query":   aws.BoolValue(apiObject.SupportsParallelQuery),
+			"version":                   aws.StringValue(apiObject.EngineVersion),
+		})
+	}
+
+	return tfList
+}
+
+// latestUpgradeTarget returns the upgrade target with the highest engine version.
+// Engine versions that can't be compared, e.g. Oracle release updates, keep the API's ascending order.
+func latestUpgradeTarget(apiObjects []*rds.UpgradeTarget) *rds.UpgradeTarget {
+	var latest *rds.UpgradeTarget
+	var latestVersion *gversion.Version
+
+	for _, apiObject := range apiObjects {
+		version, err := gversion.NewVersion(aws.StringValue(apiObject.EngineVersion))
+
+		if latest == nil || err != nil || latestVersion == nil || !version.LessThan(latestVersion) {
+			latest = apiObject
+			latestVersion = version
+		}
+	}
+
+	return latest
+}
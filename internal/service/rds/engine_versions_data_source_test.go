@@ -0,0 +1,74 @@
+package rds_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSEngineVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_versions.test"
+	engine := "aurora-postgresql"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_basic(engine),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine", engine),
+					resource.TestMatchResourceAttr(dataSourceName, "engine_versions.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.parameter_group_family"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0", dataSourceName, "engine_versions.0.version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_upgradeTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_upgradeTargets,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.0.version", "8.0.28"),
+					resource.TestMatchResourceAttr(dataSourceName, "engine_versions.0.valid_upgrade_targets.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.preferred_minor_version_upgrade_target"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEngineVersionsDataSourceConfig_basic(engine string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_versions" "test" {
+  engine = %[1]q
+}
+`, engine)
+}
+
+const testAccEngineVersionsDataSourceConfig_upgradeTargets = `
+data "aws_rds_engine_versions" "test" {
+  engine      = "mysql"
+  version     = "8.0.28"
+  include_all = true
+}
+`
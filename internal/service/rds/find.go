@@ -163,6 +163,37 @@ func FindDBSubnetGroupByName(ctx context.Context, conn *rds.RDS, name string) (*
 	return dbSubnetGroup, nil
 }
 
+func FindCustomDBEngineVersionByTwoPartKey(ctx context.Context, conn *rds.RDS, engine, engineVersion string) (*rds.DBEngineVersion, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true),
+	}
+
+	output, err := conn.DescribeDBEngineVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBEngineVersions) == 0 || output.DBEngineVersions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBEngineVersions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DBEngineVersions[0], nil
+}
+
 func FindEventSubscriptionByID(ctx context.Context, conn *rds.RDS, id string) (*rds.EventSubscription, error) {
 	input := &rds.DescribeEventSubscriptionsInput{
 		SubscriptionName: aws.String(id),
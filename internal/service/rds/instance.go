@@ -154,10 +154,23 @@ func ResourceInstance() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"deployment_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"green_instance_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(30),
+						},
 					},
 				},
 			},
@@ -1842,6 +1855,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Get("identifier").(string), err)
 			}
+			d.Set("blue_green_update", []interface{}{map[string]interface{}{
+				"deployment_identifier":     aws.StringValue(deploymentIdentifier),
+				"enabled":                   true,
+				"green_instance_identifier": targetARN.Identifier,
+				"switchover_timeout":        d.Get("blue_green_update.0.switchover_timeout").(int),
+			}})
 			_, err = waitDBInstanceAvailableSDKv2(ctx, conn, targetARN.Identifier, deadline.Remaining())
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Get("identifier").(string), err)
@@ -1854,7 +1873,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Switching over Blue/Green Deployment", d.Get("identifier").(string))
 
-			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), d.Get("blue_green_update.0.switchover_timeout").(int), deadline.Remaining())
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get("identifier").(string), err)
 			}
@@ -1971,6 +1990,11 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		if d.HasChange("iops") || !isStorageTypeGP3BelowAllocatedStorageThreshold(d) {
 			input.Iops = aws.Int32(int32(d.Get("iops").(int)))
 		}
+
+		// gp3 IOPS and storage throughput above the threshold must be modified together.
+		if isStorageTypeGP3AboveAllocatedStorageThreshold(d) {
+			input.StorageThroughput = aws.Int32(int32(d.Get("storage_throughput").(int)))
+		}
 	}
 
 	if d.HasChange("auto_minor_version_upgrade") {
@@ -2152,6 +2176,11 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 	if d.HasChange("storage_throughput") {
 		needsModify = true
 		input.StorageThroughput = aws.Int32(int32(d.Get("storage_throughput").(int)))
+
+		if isStorageTypeGP3AboveAllocatedStorageThreshold(d) {
+			input.AllocatedStorage = aws.Int32(int32(d.Get("allocated_storage").(int)))
+			input.Iops = aws.Int32(int32(d.Get("iops").(int)))
+		}
 	}
 
 	if d.HasChange("storage_type") {
@@ -2199,9 +2228,21 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, resourceID st
 	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, resourceID, timeout); err != nil {
 		return fmt.Errorf("waiting for completion: %w", err)
 	}
+
+	// Storage modifications applied immediately may still be pending when the instance first reports as available.
+	if input.ApplyImmediately && dbInstanceModifyInputHasStorageChanges(input) {
+		if _, err := waitDBInstanceStorageModifiedSDKv2(ctx, conn, resourceID, timeout); err != nil {
+			return fmt.Errorf("waiting for storage modifications: %w", err)
+		}
+	}
+
 	return nil
 }
 
+func dbInstanceModifyInputHasStorageChanges(input *rds_sdkv2.ModifyDBInstanceInput) bool {
+	return input.AllocatedStorage != nil || input.Iops != nil || input.StorageThroughput != nil || input.StorageType != nil
+}
+
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn()
 
@@ -2302,6 +2343,10 @@ func isStorageTypeGP3BelowAllocatedStorageThreshold(d *schema.ResourceData) bool
 	return false
 }
 
+func isStorageTypeGP3AboveAllocatedStorageThreshold(d *schema.ResourceData) bool {
+	return d.Get("storage_type").(string) == storageTypeGP3 && !isStorageTypeGP3BelowAllocatedStorageThreshold(d)
+}
+
 func dbSetResourceDataEngineVersionFromInstance(d *schema.ResourceData, c *rds.DBInstance) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
@@ -2459,6 +2504,31 @@ func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id strin
 	}
 }
 
+// statusDBInstanceStorageSDKv2 reports an available or storage-optimizing instance as modifying while storage modifications are still pending.
+func statusDBInstanceStorageSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.DBInstanceStatus)
+
+		if v := output.PendingModifiedValues; v != nil && (v.AllocatedStorage != nil || v.Iops != nil || v.StorageThroughput != nil || v.StorageType != nil) {
+			if status == InstanceStatusAvailable || status == InstanceStatusStorageOptimization {
+				status = InstanceStatusModifying
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
 func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2545,6 +2615,30 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 	return nil, err
 }
 
+func waitDBInstanceStorageModifiedSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusModifying,
+			InstanceStatusStorageFull,
+		},
+		// The new storage configuration is in effect once the instance is optimizing storage.
+		Target:                    []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:                   statusDBInstanceStorageSDKv2(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2719,6 +2813,7 @@ func dbInstanceValidBlueGreenEngines() []string {
 	return []string{
 		InstanceEngineMariaDB,
 		InstanceEngineMySQL,
+		InstanceEnginePostgres,
 	}
 }
 
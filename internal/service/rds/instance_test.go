@@ -4846,6 +4846,49 @@ func TestAccRDSInstance_BlueGreenDeployment_updateInstanceClass(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_postgres(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.deployment_identifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.green_instance_identifier", ""),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+					resource.TestMatchResourceAttr(resourceName, "blue_green_update.0.deployment_identifier", regexp.MustCompile(`^bgd-`)),
+					resource.TestMatchResourceAttr(resourceName, "blue_green_update.0.green_instance_identifier", regexp.MustCompile(fmt.Sprintf(`^%s-green-`, rName))),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -5300,6 +5343,52 @@ func TestAccRDSInstance_storageThroughput(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_storageThroughputAndIOPSIndependently(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_storageThroughput(rName, 12000, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "iops", "12000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "500"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageThroughput(rName, 12000, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "iops", "12000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "600"),
+					testAccCheckInstanceNoPendingStorageModifications(&v),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageThroughput(rName, 14000, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "iops", "14000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "600"),
+					testAccCheckInstanceNoPendingStorageModifications(&v),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_storageTypePostgres(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -5650,6 +5739,16 @@ func dbInstanceIdentity(v *rds.DBInstance) string {
 	return aws.StringValue(v.DbiResourceId)
 }
 
+func testAccCheckInstanceNoPendingStorageModifications(v *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if p := v.PendingModifiedValues; p != nil && (p.AllocatedStorage != nil || p.Iops != nil || p.StorageThroughput != nil || p.StorageType != nil) {
+			return fmt.Errorf("RDS DB Instance (%s) has pending storage modifications: %s", aws.StringValue(v.DBInstanceIdentifier), p)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(ctx context.Context, n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -10354,6 +10453,52 @@ data "aws_rds_orderable_db_instance" "updated" {
 `, rName))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_postgres(rName string, updated bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassPostgres(),
+		fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  # Blue/Green Deployments for RDS for PostgreSQL require logical replication.
+  parameter {
+    name         = "rds.logical_replication"
+    value        = "1"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = %[2]t ? data.aws_rds_orderable_db_instance.updated.instance_class : data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = aws_db_parameter_group.test.name
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled            = true
+    switchover_timeout = 600
+  }
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "postgresql-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName, updated))
+}
+
 func testAccInstanceConfig_BlueGreenDeployment_promote(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -34,6 +34,22 @@ func statusEventSubscription(ctx context.Context, conn *rds.RDS, id string) retr
 	}
 }
 
+func statusCustomDBEngineVersion(ctx context.Context, conn *rds.RDS, engine, engineVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 // statusDBProxyEndpoint fetches the ProxyEndpoint and its Status
 func statusDBProxyEndpoint(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
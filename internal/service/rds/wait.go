@@ -5,8 +5,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitEventSubscriptionCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -68,6 +70,63 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *rds.RDS, id string,
 }
 
 // waitDBProxyEndpointAvailable waits for a DBProxyEndpoint to return Available
+func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{CustomDBEngineVersionStatusCreating, CustomDBEngineVersionStatusPendingValidation},
+		Target:     []string{CustomDBEngineVersionStatusAvailable},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, engine, engineVersion string, status string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{CustomDBEngineVersionStatusAvailable, CustomDBEngineVersionStatusInactive, CustomDBEngineVersionStatusInactiveExceptRestore},
+		Target:                    []string{status},
+		Refresh:                   statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{CustomDBEngineVersionStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBProxyEndpointAvailable(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBProxyEndpoint, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -177,6 +236,30 @@ func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 	return nil, err
 }
 
+func waitDBClusterMemberPromotionTierUpdated(ctx context.Context, conn *rds.RDS, clusterID, id string, promotionTier int, timeout time.Duration) error {
+	checkFunc := func() (bool, error) {
+		output, err := FindDBClusterByID(ctx, conn, clusterID)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if aws.StringValue(v.DBInstanceIdentifier) == id {
+				return aws.Int64Value(v.PromotionTier) == int64(promotionTier), nil
+			}
+		}
+
+		return false, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                5 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
 func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
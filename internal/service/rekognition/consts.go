@@ -0,0 +1,17 @@
+package rekognition
+
+const (
+	streamProcessorConnectedHomeLabelAll     = "ALL"
+	streamProcessorConnectedHomeLabelPackage = "PACKAGE"
+	streamProcessorConnectedHomeLabelPerson  = "PERSON"
+	streamProcessorConnectedHomeLabelPet     = "PET"
+)
+
+func streamProcessorConnectedHomeLabels_Values() []string {
+	return []string{
+		streamProcessorConnectedHomeLabelAll,
+		streamProcessorConnectedHomeLabelPackage,
+		streamProcessorConnectedHomeLabelPerson,
+		streamProcessorConnectedHomeLabelPet,
+	}
+}
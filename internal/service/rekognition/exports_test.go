@@ -0,0 +1,6 @@
+package rekognition
+
+// Exports for use in tests only.
+var (
+	FindProjectVersionByTwoPartKey = findProjectVersionByTwoPartKey
+)
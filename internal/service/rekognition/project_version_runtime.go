@@ -0,0 +1,278 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rekognition_project_version_runtime", name="Project Version Runtime")
+func ResourceProjectVersionRuntime() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectVersionRuntimeCreate,
+		ReadWithoutTimeout:   resourceProjectVersionRuntimeRead,
+		DeleteWithoutTimeout: resourceProjectVersionRuntimeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_inference_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"project_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const projectVersionRuntimeResourceIDPartCount = 2
+
+func resourceProjectVersionRuntimeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	projectARN := d.Get("project_arn").(string)
+	versionName := d.Get("version_name").(string)
+	id, err := flex.FlattenResourceId([]string{projectARN, versionName}, projectVersionRuntimeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	version, err := findProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", id, err)
+	}
+
+	input := &rekognition.StartProjectVersionInput{
+		MinInferenceUnits: aws.Int64(int64(d.Get("min_inference_units").(int))),
+		ProjectVersionArn: version.ProjectVersionArn,
+	}
+
+	if v, ok := d.GetOk("max_inference_units"); ok {
+		input.MaxInferenceUnits = aws.Int64(int64(v.(int)))
+	}
+
+	_, err = conn.StartProjectVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Rekognition Project Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitProjectVersionRunning(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) start: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProjectVersionRuntimeRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionRuntimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectVersionRuntimeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectARN, versionName := parts[0], parts[1]
+	version, err := findProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if err == nil {
+		// A stopped model has no runtime.
+		switch status := aws.StringValue(version.Status); status {
+		case rekognition.ProjectVersionStatusStarting, rekognition.ProjectVersionStatusRunning:
+		default:
+			err = &retry.NotFoundError{
+				Message: status,
+			}
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version Runtime (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version Runtime (%s): %s", d.Id(), err)
+	}
+
+	d.Set("max_inference_units", version.MaxInferenceUnits)
+	d.Set("min_inference_units", version.MinInferenceUnits)
+	d.Set("project_arn", projectARN)
+	d.Set("project_version_arn", version.ProjectVersionArn)
+	d.Set("status", version.Status)
+	d.Set("version_name", versionName)
+
+	return diags
+}
+
+func resourceProjectVersionRuntimeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectVersionRuntimeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectARN, versionName := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Stopping Rekognition Project Version: %s", d.Id())
+	_, err = conn.StopProjectVersionWithContext(ctx, &rekognition.StopProjectVersionInput{
+		ProjectVersionArn: aws.String(d.Get("project_version_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectVersionStopped(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) stop: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) (*rekognition.ProjectVersionDescription, error) {
+	input := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: aws.StringSlice([]string{versionName}),
+	}
+
+	output, err := conn.DescribeProjectVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ProjectVersionDescriptions) == 0 || output.ProjectVersionDescriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ProjectVersionDescriptions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ProjectVersionDescriptions[0], nil
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectVersionRunning(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusStarting},
+		Target:  []string{rekognition.ProjectVersionStatusRunning},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusRunning, rekognition.ProjectVersionStatusStopping},
+		Target:  []string{rekognition.ProjectVersionStatusStopped},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,136 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training a Custom Labels model is outside the scope of these tests.
+const (
+	envVarProjectARN             = "REKOGNITION_PROJECT_ARN"
+	envVarProjectARNMessageError = "Environment variable REKOGNITION_PROJECT_ARN is not set. " +
+		"It must be the ARN of a Rekognition Custom Labels project with a trained, stopped model version."
+	envVarVersionName             = "REKOGNITION_PROJECT_VERSION_NAME"
+	envVarVersionNameMessageError = "Environment variable REKOGNITION_PROJECT_VERSION_NAME is not set. " +
+		"It must be the name of a trained, stopped model version in the REKOGNITION_PROJECT_ARN project."
+)
+
+func TestAccRekognitionProjectVersionRuntime_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := envvar.SkipIfEmpty(t, envVarProjectARN, envVarProjectARNMessageError)
+	versionName := envvar.SkipIfEmpty(t, envVarVersionName, envVarVersionNameMessageError)
+	var v rekognition.ProjectVersionDescription
+	resourceName := "aws_rekognition_project_version_runtime.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionRuntimeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionRuntimeConfig_basic(projectARN, versionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionRuntimeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "project_arn", projectARN),
+					resource.TestCheckResourceAttrSet(resourceName, "project_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "version_name", versionName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionRuntimeExists(ctx context.Context, n string, v *rekognition.ProjectVersionDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Project Version Runtime ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		output, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionRuntimeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version_runtime" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if status := aws.StringValue(output.Status); status != rekognition.ProjectVersionStatusStopped {
+				return fmt.Errorf("Rekognition Project Version %s still %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVersionRuntimeConfig_basic(projectARN, versionName string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project_version_runtime" "test" {
+  project_arn         = %[1]q
+  version_name        = %[2]q
+  min_inference_units = 1
+}
+`, projectARN, versionName)
+}
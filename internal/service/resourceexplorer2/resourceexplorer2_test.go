@@ -10,6 +10,11 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultViewAssociation": {
+			"basic":      testAccDefaultViewAssociation_basic,
+			"disappears": testAccDefaultViewAssociation_disappears,
+			"update":     testAccDefaultViewAssociation_update,
+		},
 		"Index": {
 			"basic":      testAccIndex_basic,
 			"disappears": testAccIndex_disappears,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -28,7 +29,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	fwboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/boolplanmodifier"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -61,7 +61,7 @@ func (r *resourceView) Schema(ctx context.Context, request resource.SchemaReques
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
-					fwboolplanmodifier.DefaultValue(false),
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"id": framework.IDAttribute(),
@@ -155,6 +155,7 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 
 	// Set values for unknowns.
 	data.ARN = types.StringValue(arn)
+	data.DefaultView = types.BoolValue(data.DefaultView.ValueBool())
 	data.ID = types.StringValue(arn)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -0,0 +1,355 @@
+package robomaker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	simulationApplicationVersionLatest = "$LATEST"
+	versionQualifierAll                = "ALL"
+)
+
+// @SDKDataSource("aws_robomaker_simulation_application", name="Simulation Application")
+func DataSourceSimulationApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSimulationApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"environment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"most_recent": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"version"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "name"},
+			},
+			"rendering_engine": softwareSuiteSchemaComputed(),
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"robot_software_suite":      softwareSuiteSchemaComputed(),
+			"simulation_software_suite": softwareSuiteSchemaComputed(),
+			"sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"most_recent"},
+			},
+		},
+	}
+}
+
+func softwareSuiteSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSimulationApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RoboMakerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+
+	if arn == "" {
+		name := d.Get("name").(string)
+		summary, err := findSimulationApplicationSummaryByName(ctx, conn, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RoboMaker Simulation Application (%s): %s", name, err)
+		}
+
+		arn = aws.StringValue(summary.Arn)
+	}
+
+	version := simulationApplicationVersionLatest
+
+	if v, ok := d.GetOk("version"); ok {
+		version = v.(string)
+	} else if d.Get("most_recent").(bool) {
+		v, err := findSimulationApplicationMostRecentVersion(ctx, conn, arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RoboMaker Simulation Application (%s) versions: %s", arn, err)
+		}
+
+		version = v
+	}
+
+	output, err := FindSimulationApplicationByTwoPartKey(ctx, conn, arn, version)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RoboMaker Simulation Application (%s) version (%s): %s", arn, version, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+	d.Set("arn", output.Arn)
+	if output.Environment != nil {
+		if err := d.Set("environment", []interface{}{map[string]interface{}{"uri": aws.StringValue(output.Environment.Uri)}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting environment: %s", err)
+		}
+	} else {
+		d.Set("environment", nil)
+	}
+	if output.LastUpdatedAt != nil {
+		d.Set("last_updated_at", aws.TimeValue(output.LastUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_at", nil)
+	}
+	d.Set("name", output.Name)
+	if output.RenderingEngine != nil {
+		if err := d.Set("rendering_engine", []interface{}{flattenSoftwareSuite(output.RenderingEngine.Name, output.RenderingEngine.Version)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting rendering_engine: %s", err)
+		}
+	} else {
+		d.Set("rendering_engine", nil)
+	}
+	d.Set("revision_id", output.RevisionId)
+	if output.RobotSoftwareSuite != nil {
+		if err := d.Set("robot_software_suite", []interface{}{flattenSoftwareSuite(output.RobotSoftwareSuite.Name, output.RobotSoftwareSuite.Version)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting robot_software_suite: %s", err)
+		}
+	} else {
+		d.Set("robot_software_suite", nil)
+	}
+	if output.SimulationSoftwareSuite != nil {
+		if err := d.Set("simulation_software_suite", []interface{}{flattenSoftwareSuite(output.SimulationSoftwareSuite.Name, output.SimulationSoftwareSuite.Version)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting simulation_software_suite: %s", err)
+		}
+	} else {
+		d.Set("simulation_software_suite", nil)
+	}
+	if err := d.Set("sources", flattenSources(output.Sources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sources: %s", err)
+	}
+	d.Set("version", output.Version)
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func FindSimulationApplicationByTwoPartKey(ctx context.Context, conn *robomaker.RoboMaker, arn, version string) (*robomaker.DescribeSimulationApplicationOutput, error) {
+	input := &robomaker.DescribeSimulationApplicationInput{
+		Application: aws.String(arn),
+	}
+	if version != simulationApplicationVersionLatest {
+		input.ApplicationVersion = aws.String(version)
+	}
+
+	output, err := conn.DescribeSimulationApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, robomaker.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findSimulationApplicationSummaryByName(ctx context.Context, conn *robomaker.RoboMaker, name string) (*robomaker.SimulationApplicationSummary, error) {
+	input := &robomaker.ListSimulationApplicationsInput{
+		Filters: []*robomaker.Filter{{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{name}),
+		}},
+	}
+
+	output, err := findSimulationApplicationSummaries(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+// findSimulationApplicationMostRecentVersion returns the highest numbered
+// published version of the specified simulation application.
+func findSimulationApplicationMostRecentVersion(ctx context.Context, conn *robomaker.RoboMaker, arn string) (string, error) {
+	input := &robomaker.ListSimulationApplicationsInput{
+		VersionQualifier: aws.String(versionQualifierAll),
+	}
+
+	output, err := findSimulationApplicationSummaries(ctx, conn, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		mostRecent    string
+		latestVersion int64 = -1
+	)
+	for _, v := range output {
+		if aws.StringValue(v.Arn) != arn {
+			continue
+		}
+
+		n, err := strconv.ParseInt(aws.StringValue(v.Version), 10, 64)
+
+		if err != nil {
+			// Skip "$LATEST".
+			continue
+		}
+
+		if n > latestVersion {
+			latestVersion = n
+			mostRecent = aws.StringValue(v.Version)
+		}
+	}
+
+	if mostRecent == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return mostRecent, nil
+}
+
+func findSimulationApplicationSummaries(ctx context.Context, conn *robomaker.RoboMaker, input *robomaker.ListSimulationApplicationsInput) ([]*robomaker.SimulationApplicationSummary, error) {
+	var output []*robomaker.SimulationApplicationSummary
+
+	err := conn.ListSimulationApplicationsPagesWithContext(ctx, input, func(page *robomaker.ListSimulationApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SimulationApplicationSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenSoftwareSuite(name, version *string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    aws.StringValue(name),
+		"version": aws.StringValue(version),
+	}
+}
+
+func flattenSources(apiObjects []*robomaker.Source) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"architecture": aws.StringValue(apiObject.Architecture),
+			"etag":         aws.StringValue(apiObject.Etag),
+			"s3_bucket":    aws.StringValue(apiObject.S3Bucket),
+			"s3_key":       aws.StringValue(apiObject.S3Key),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,86 @@
+package robomaker_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	simulationApplicationNameKey       = "TF_AWS_ROBOMAKER_SIMULATION_APPLICATION_NAME"
+	envVarSimulationApplicationNameErr = "Name of an existing RoboMaker simulation application with at least one published version."
+)
+
+func TestAccRoboMakerSimulationApplicationDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := envvar.SkipIfEmpty(t, simulationApplicationNameKey, envVarSimulationApplicationNameErr)
+	dataSourceName := "data.aws_robomaker_simulation_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, robomaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationApplicationDataSourceConfig_name(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "arn", "robomaker", regexp.MustCompile(`simulation-application/.+`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_updated_at"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "revision_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "simulation_software_suite.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "$LATEST"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoboMakerSimulationApplicationDataSource_mostRecent(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := envvar.SkipIfEmpty(t, simulationApplicationNameKey, envVarSimulationApplicationNameErr)
+	dataSourceName := "data.aws_robomaker_simulation_application.test"
+	latestDataSourceName := "data.aws_robomaker_simulation_application.latest"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, robomaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationApplicationDataSourceConfig_mostRecent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", latestDataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", latestDataSourceName, "name"),
+					resource.TestMatchResourceAttr(dataSourceName, "version", regexp.MustCompile(`^[0-9]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSimulationApplicationDataSourceConfig_name(name string) string {
+	return fmt.Sprintf(`
+data "aws_robomaker_simulation_application" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
+func testAccSimulationApplicationDataSourceConfig_mostRecent(name string) string {
+	return fmt.Sprintf(`
+data "aws_robomaker_simulation_application" "latest" {
+  name = %[1]q
+}
+
+data "aws_robomaker_simulation_application" "test" {
+  arn         = data.aws_robomaker_simulation_application.latest.arn
+  most_recent = true
+}
+`, name)
+}
@@ -0,0 +1,248 @@
+package route53recoverycluster
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_route53recoverycluster_routing_control_state")
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoutingControlStateCreate,
+		ReadWithoutTimeout:   resourceRoutingControlStateRead,
+		UpdateWithoutTimeout: resourceRoutingControlStateUpdate,
+		DeleteWithoutTimeout: resourceRoutingControlStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(r53rc.RoutingControlState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	routingControlARN := d.Get("routing_control_arn").(string)
+
+	if err := updateRoutingControlState(ctx, d, meta, routingControlARN); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route53 Recovery Cluster Routing Control State (%s): %s", routingControlARN, err)
+	}
+
+	d.SetId(routingControlARN)
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cluster, err := findClusterByRoutingControlARN(ctx, meta, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Recovery Cluster Routing Control State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Cluster Routing Control State (%s): %s", d.Id(), err)
+	}
+
+	var output *r53rc.GetRoutingControlStateOutput
+	err = forEachClusterEndpoint(ctx, meta, cluster, func(conn *r53rc.Route53RecoveryCluster) error {
+		var err error
+
+		output, err = conn.GetRoutingControlStateWithContext(ctx, &r53rc.GetRoutingControlStateInput{
+			RoutingControlArn: aws.String(d.Id()),
+		})
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Route53 Recovery Cluster Routing Control State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Cluster Routing Control State (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_arn", cluster.ClusterArn)
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("routing_control_name", output.RoutingControlName)
+	d.Set("state", output.RoutingControlState)
+
+	return diags
+}
+
+func resourceRoutingControlStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("state") {
+		if err := updateRoutingControlState(ctx, d, meta, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Cluster Routing Control State (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Routing controls always have a state; the current state is left unchanged.
+	log.Printf("[WARN] Route53 Recovery Cluster Routing Control State (%s) only removed from Terraform state", d.Id())
+
+	return nil
+}
+
+func updateRoutingControlState(ctx context.Context, d *schema.ResourceData, meta interface{}, routingControlARN string) error {
+	cluster, err := findClusterByRoutingControlARN(ctx, meta, routingControlARN)
+
+	if err != nil {
+		return err
+	}
+
+	input := &r53rc.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(routingControlARN),
+		RoutingControlState: aws.String(d.Get("state").(string)),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	return forEachClusterEndpoint(ctx, meta, cluster, func(conn *r53rc.Route53RecoveryCluster) error {
+		_, err := conn.UpdateRoutingControlStateWithContext(ctx, input)
+
+		return err
+	})
+}
+
+// findClusterByRoutingControlARN returns the cluster that hosts the specified routing control.
+// The routing control's cluster is found via its control panel.
+func findClusterByRoutingControlARN(ctx context.Context, meta interface{}, arn string) (*r53rcc.Cluster, error) {
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn()
+
+	routingControl, err := conn.DescribeRoutingControlWithContext(ctx, &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: arn,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if routingControl == nil || routingControl.RoutingControl == nil {
+		return nil, tfresource.NewEmptyResultError(arn)
+	}
+
+	controlPanel, err := conn.DescribeControlPanelWithContext(ctx, &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: routingControl.RoutingControl.ControlPanelArn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if controlPanel == nil || controlPanel.ControlPanel == nil {
+		return nil, tfresource.NewEmptyResultError(routingControl.RoutingControl.ControlPanelArn)
+	}
+
+	cluster, err := conn.DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+		ClusterArn: controlPanel.ControlPanel.ClusterArn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil || cluster.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(controlPanel.ControlPanel.ClusterArn)
+	}
+
+	if len(cluster.Cluster.ClusterEndpoints) == 0 {
+		return nil, tfresource.NewEmptyResultError(controlPanel.ControlPanel.ClusterArn)
+	}
+
+	return cluster.Cluster, nil
+}
+
+// forEachClusterEndpoint calls f with a client for each of the cluster's regional endpoints in turn
+// until a call succeeds or fails with an error that another endpoint would not resolve.
+// See https://docs.aws.amazon.com/r53recovery/latest/dg/routing-control.update.api.html.
+func forEachClusterEndpoint(ctx context.Context, meta interface{}, cluster *r53rcc.Cluster, f func(*r53rc.Route53RecoveryCluster) error) error {
+	var errs *multierror.Error
+
+	for _, endpoint := range cluster.ClusterEndpoints {
+		if endpoint == nil {
+			continue
+		}
+
+		conn := r53rc.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithEndpoint(aws.StringValue(endpoint.Endpoint)).WithRegion(aws.StringValue(endpoint.Region)))
+
+		err := f(conn)
+
+		if err == nil {
+			return nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, r53rc.ErrCodeAccessDeniedException, r53rc.ErrCodeConflictException, r53rc.ErrCodeResourceNotFoundException, r53rc.ErrCodeValidationException) {
+			return err
+		}
+
+		log.Printf("[WARN] Route53 Recovery Cluster endpoint (%s): %s", aws.StringValue(endpoint.Endpoint), err)
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs.ErrorOrNil()
+}
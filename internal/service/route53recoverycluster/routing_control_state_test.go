@@ -0,0 +1,70 @@
+package route53recoverycluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Not parallel because of low quota limits.
+// ServiceQuotaExceededException: AwsAccountId(X) has 2 Meridian clusters. Limit 2
+func TestAccRoute53RecoveryClusterRoutingControlState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53recoverycluster_routing_control_state.test"
+	routingControlResourceName := "aws_route53recoverycontrolconfig_routing_control.test"
+	clusterResourceName := "aws_route53recoverycontrolconfig_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, r53rcc.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, r53rcc.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "On"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", routingControlResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "safety_rules_to_override.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "state", "On"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "Off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", routingControlResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingControlStateConfig_basic(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+
+resource "aws_route53recoverycluster_routing_control_state" "test" {
+  routing_control_arn = aws_route53recoverycontrolconfig_routing_control.test.arn
+  state               = %[2]q
+}
+`, rName, state)
+}
@@ -142,6 +142,11 @@ func ResourceBucket() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_destroy_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -1390,7 +1395,10 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 				objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
 			}
 
-			if n, err := EmptyBucket(ctx, conn, d.Id(), objectLockEnabled); err != nil {
+			// Unset (0) means pages of objects are deleted one at a time.
+			parallelism := d.Get("force_destroy_parallelism").(int)
+
+			if n, err := EmptyBucket(ctx, conn, d.Id(), objectLockEnabled, parallelism); err != nil {
 				return diag.Errorf("emptying S3 Bucket (%s): %s", d.Id(), err)
 			} else {
 				log.Printf("[DEBUG] Deleted %d S3 objects", n)
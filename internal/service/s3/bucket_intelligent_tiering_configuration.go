@@ -30,6 +30,8 @@ func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketIntelligentTieringConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -195,6 +197,56 @@ func resourceBucketIntelligentTieringConfigurationDelete(ctx context.Context, d
 	return diags
 }
 
+func resourceBucketIntelligentTieringConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.Get("tiering").(*schema.Set)
+
+	if !ok {
+		return nil
+	}
+
+	days := make(map[string]int)
+
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier, n := tfMap["access_tier"].(string), tfMap["days"].(int)
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf("tiering: access tier %s is configured more than once", accessTier)
+		}
+
+		days[accessTier] = n
+
+		// Unknown values are 0 during planning.
+		if n == 0 {
+			continue
+		}
+
+		switch accessTier {
+		case s3.IntelligentTieringAccessTierArchiveAccess:
+			if n < intelligentTieringArchiveAccessMinDays || n > intelligentTieringMaxDays {
+				return fmt.Errorf("tiering: days for access tier %s must be between %d and %d, got %d", accessTier, intelligentTieringArchiveAccessMinDays, intelligentTieringMaxDays, n)
+			}
+		case s3.IntelligentTieringAccessTierDeepArchiveAccess:
+			if n < intelligentTieringDeepArchiveAccessMinDays || n > intelligentTieringMaxDays {
+				return fmt.Errorf("tiering: days for access tier %s must be between %d and %d, got %d", accessTier, intelligentTieringDeepArchiveAccessMinDays, intelligentTieringMaxDays, n)
+			}
+		}
+	}
+
+	archiveDays, deepArchiveDays := days[s3.IntelligentTieringAccessTierArchiveAccess], days[s3.IntelligentTieringAccessTierDeepArchiveAccess]
+
+	if archiveDays != 0 && deepArchiveDays != 0 && deepArchiveDays <= archiveDays {
+		return fmt.Errorf("tiering: days for access tier %s (%d) must be greater than days for access tier %s (%d)", s3.IntelligentTieringAccessTierDeepArchiveAccess, deepArchiveDays, s3.IntelligentTieringAccessTierArchiveAccess, archiveDays)
+	}
+
+	return nil
+}
+
 const bucketIntelligentTieringConfigurationResourceIDSeparator = ":"
 
 func BucketIntelligentTieringConfigurationCreateResourceID(bucketName, configurationName string) string {
@@ -3,6 +3,7 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -74,6 +75,81 @@ func TestAccS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_tiering(t *testing.T) {
+	ctx := acctest.Context(t)
+	var itc s3.IntelligentTieringConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, "Enabled", 90, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "ARCHIVE_ACCESS",
+						"days":        "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, "Disabled", 180, 730),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(ctx, resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "ARCHIVE_ACCESS",
+						"days":        "180",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "730",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfiguration_tieringInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, "Enabled", 30, 180),
+				ExpectError: regexp.MustCompile(`days for access tier ARCHIVE_ACCESS must be between 90 and 730`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, "Enabled", 365, 180),
+				ExpectError: regexp.MustCompile(`must be greater than days for access tier ARCHIVE_ACCESS`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
 	ctx := acctest.Context(t)
 	var itc s3.IntelligentTieringConfiguration
@@ -209,6 +285,30 @@ resource "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketIntelligentTieringConfigurationConfig_tiering(rName, status string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  status = %[2]q
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[4]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, status, archiveDays, deepArchiveDays)
+}
+
 func testAccBucketIntelligentTieringConfigurationConfig_filterPrefix(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
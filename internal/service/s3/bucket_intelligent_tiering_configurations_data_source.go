@@ -0,0 +1,152 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_s3_bucket_intelligent_tiering_configurations")
+func DataSourceBucketIntelligentTieringConfigurations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketIntelligentTieringConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tiering": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_tier": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceBucketIntelligentTieringConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucketName := d.Get("bucket").(string)
+
+	output, err := FindBucketIntelligentTieringConfigurations(ctx, conn, bucketName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Intelligent-Tiering Configurations (%s): %s", bucketName, err)
+	}
+
+	var configurations []interface{}
+	var names []string
+
+	for _, v := range output {
+		tfMap := map[string]interface{}{
+			"name":    aws.StringValue(v.Id),
+			"status":  aws.StringValue(v.Status),
+			"tiering": flattenTierings(v.Tierings),
+		}
+
+		if v.Filter != nil {
+			tfMap["filter"] = []interface{}{flattenIntelligentTieringFilter(ctx, v.Filter)}
+		}
+
+		configurations = append(configurations, tfMap)
+		names = append(names, aws.StringValue(v.Id))
+	}
+
+	d.SetId(bucketName)
+	if err := d.Set("configurations", configurations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configurations: %s", err)
+	}
+	d.Set("names", names)
+
+	return diags
+}
+
+func FindBucketIntelligentTieringConfigurations(ctx context.Context, conn *s3.S3, bucketName string) ([]*s3.IntelligentTieringConfiguration, error) {
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucketName),
+	}
+	var output []*s3.IntelligentTieringConfiguration
+
+	for {
+		page, err := conn.ListBucketIntelligentTieringConfigurationsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.IntelligentTieringConfigurationList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if !aws.BoolValue(page.IsTruncated) || aws.StringValue(page.NextContinuationToken) == "" {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketIntelligentTieringConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_intelligent_tiering_configurations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "configurations.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName+"-1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName+"-2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"name":                  rName + "-1",
+						"status":                "Enabled",
+						"filter.#":              "0",
+						"tiering.#":             "1",
+						"tiering.0.access_tier": "DEEP_ARCHIVE_ACCESS",
+						"tiering.0.days":        "180",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "configurations.*", map[string]string{
+						"name":            rName + "-2",
+						"status":          "Disabled",
+						"filter.#":        "1",
+						"filter.0.prefix": "p1/",
+						"tiering.#":       "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketIntelligentTieringConfigurationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-1"
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = "%[1]s-2"
+  status = "Disabled"
+
+  filter {
+    prefix = "p1/"
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 365
+  }
+}
+
+data "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [
+    aws_s3_bucket_intelligent_tiering_configuration.test1,
+    aws_s3_bucket_intelligent_tiering_configuration.test2,
+  ]
+}
+`, rName)
+}
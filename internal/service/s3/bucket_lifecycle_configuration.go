@@ -33,6 +33,8 @@ func ResourceBucketLifecycleConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -445,3 +447,137 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 	}
 	return false
 }
+
+// resourceBucketLifecycleConfigurationCustomizeDiff acts as a plan-time validation of
+// object size ranges and transition combinations that S3 would otherwise reject at apply time.
+// Days values of 0 are treated as unset (or not yet known) and are not validated.
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if err := validateLifecycleRuleFilterObjectSizes(v[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("rule (%s): %w", id, err)
+			}
+		}
+
+		var expirationDays int
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			expirationDays = v[0].(map[string]interface{})["days"].(int)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok {
+			if err := validateLifecycleRuleTransitions("transition", "days", lifecycleRuleTransitionDays(v.List(), "days"), expirationDays); err != nil {
+				return fmt.Errorf("rule (%s): %w", id, err)
+			}
+		}
+
+		// Noncurrent version expiration may coincide with a noncurrent version transition.
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok {
+			if err := validateLifecycleRuleTransitions("noncurrent_version_transition", "noncurrent_days", lifecycleRuleTransitionDays(v.List(), "noncurrent_days"), 0); err != nil {
+				return fmt.Errorf("rule (%s): %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lifecycleRuleTransitionDays returns the configured days for each storage class.
+// Date-based transitions are ignored.
+func lifecycleRuleTransitionDays(tfList []interface{}, daysKey string) map[string]int {
+	days := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["date"].(string); ok && v != "" {
+			continue
+		}
+
+		if v, ok := tfMap[daysKey].(int); ok && v > 0 {
+			days[tfMap["storage_class"].(string)] = v
+		}
+	}
+
+	return days
+}
+
+func validateLifecycleRuleTransitions(blockName, daysKey string, transitionDays map[string]int, expirationDays int) error {
+	const (
+		minimumInfrequentAccessDays = 30
+	)
+	infrequentAccessStorageClasses := []string{
+		s3.TransitionStorageClassStandardIa,
+		s3.TransitionStorageClassOnezoneIa,
+	}
+	archiveStorageClasses := []string{
+		s3.TransitionStorageClassGlacierIr,
+		s3.TransitionStorageClassGlacier,
+		s3.TransitionStorageClassDeepArchive,
+	}
+
+	for _, iaStorageClass := range infrequentAccessStorageClasses {
+		iaDays, ok := transitionDays[iaStorageClass]
+
+		if !ok {
+			continue
+		}
+
+		if iaDays < minimumInfrequentAccessDays {
+			return fmt.Errorf("%s %s (%d) for storage class %s must be at least %d", blockName, daysKey, iaDays, iaStorageClass, minimumInfrequentAccessDays)
+		}
+
+		for _, archiveStorageClass := range archiveStorageClasses {
+			if archiveDays, ok := transitionDays[archiveStorageClass]; ok && archiveDays-iaDays < minimumInfrequentAccessDays {
+				return fmt.Errorf("%s %s (%d) for storage class %s must be at least %d days after the %s transition (%d)", blockName, daysKey, archiveDays, archiveStorageClass, minimumInfrequentAccessDays, iaStorageClass, iaDays)
+			}
+		}
+	}
+
+	if expirationDays > 0 {
+		for storageClass, days := range transitionDays {
+			if expirationDays <= days {
+				return fmt.Errorf("expiration %s (%d) must be greater than %s %s (%d) for storage class %s", daysKey, expirationDays, blockName, daysKey, days, storageClass)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateLifecycleRuleFilterObjectSizes(tfMap map[string]interface{}) error {
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		greaterThan, lessThan := tfMap["object_size_greater_than"].(int), tfMap["object_size_less_than"].(int)
+
+		if greaterThan > 0 && lessThan > 0 && greaterThan >= lessThan {
+			return fmt.Errorf("filter.and object_size_greater_than (%d) must be less than object_size_less_than (%d)", greaterThan, lessThan)
+		}
+
+		return nil
+	}
+
+	greaterThan, greaterThanNull, _ := nullable.Int(tfMap["object_size_greater_than"].(string)).Value()
+	lessThan, lessThanNull, _ := nullable.Int(tfMap["object_size_less_than"].(string)).Value()
+
+	if !greaterThanNull && !lessThanNull && greaterThan >= lessThan {
+		return fmt.Errorf("filter object_size_greater_than (%d) must be less than object_size_less_than (%d)", greaterThan, lessThan)
+	}
+
+	return nil
+}
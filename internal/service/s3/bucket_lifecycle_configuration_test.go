@@ -3,6 +3,7 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -903,6 +904,88 @@ func TestAccS3BucketLifecycleConfiguration_EmptyFilter_NonCurrentVersions(t *tes
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_Filter_ObjectSizeRangeAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_filterObjectSizeRangeAndTags(rName, 131072, 1048576),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":       "1",
+						"filter.0.and.#": "1",
+						"filter.0.and.0.object_size_greater_than": "131072",
+						"filter.0.and.0.object_size_less_than":    "1048576",
+						"filter.0.and.0.prefix":                   "logs/",
+						"filter.0.and.0.tags.%":                   "1",
+						"filter.0.and.0.tags.key1":                "value1",
+						"id":                                      rName,
+						"status":                                  tfs3.LifecycleRuleStatusEnabled,
+						"transition.#":                            "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_Filter_ObjectSizeRangeInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_filterObjectSizeRangeAndTags(rName, 1048576, 131072),
+				ExpectError: regexp.MustCompile(`object_size_greater_than \(1048576\) must be less than object_size_less_than \(131072\)`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_TransitionDays_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionDays(rName, 365, 15, 90),
+				ExpectError: regexp.MustCompile(`transition days \(15\) for storage class STANDARD_IA must be at least 30`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionDays(rName, 365, 30, 45),
+				ExpectError: regexp.MustCompile(`transition days \(45\) for storage class GLACIER must be at least 30 days after the STANDARD_IA transition \(30\)`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionDays(rName, 90, 30, 90),
+				ExpectError: regexp.MustCompile(`expiration days \(90\) must be greater than transition days \(90\) for storage class GLACIER`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_migrate_noChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1766,3 +1849,83 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccBucketLifecycleConfigurationConfig_filterObjectSizeRangeAndTags(rName string, sizeGreaterThan, sizeLessThan int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id = %[1]q
+
+    expiration {
+      days = 365
+    }
+
+    filter {
+      and {
+        object_size_greater_than = %[2]d
+        object_size_less_than    = %[3]d
+        prefix                   = "logs/"
+
+        tags = {
+          key1 = "value1"
+        }
+      }
+    }
+
+    status = "Enabled"
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+
+    transition {
+      days          = 90
+      storage_class = "GLACIER"
+    }
+  }
+}
+`, rName, sizeGreaterThan, sizeLessThan)
+}
+
+func testAccBucketLifecycleConfigurationConfig_transitionDays(rName string, expirationDays, standardIADays, glacierDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id = %[1]q
+
+    expiration {
+      days = %[2]d
+    }
+
+    filter {
+      prefix = "logs/"
+    }
+
+    status = "Enabled"
+
+    transition {
+      days          = %[3]d
+      storage_class = "STANDARD_IA"
+    }
+
+    transition {
+      days          = %[4]d
+      storage_class = "GLACIER"
+    }
+  }
+}
+`, rName, expirationDays, standardIADays, glacierDays)
+}
@@ -0,0 +1,427 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_s3_bucket_notification_target")
+func ResourceBucketNotificationTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketNotificationTargetCreate,
+		ReadWithoutTimeout:   resourceBucketNotificationTargetRead,
+		UpdateWithoutTimeout: resourceBucketNotificationTargetUpdate,
+		DeleteWithoutTimeout: resourceBucketNotificationTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny(BucketNotificationTargetResourceIDSeparator),
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lambda_function_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+			"queue_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+			"topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"lambda_function_arn", "queue_arn", "topic_arn"},
+			},
+		},
+	}
+}
+
+func resourceBucketNotificationTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	configurationID := id.PrefixedUniqueId("tf-s3-notification-")
+	if v, ok := d.GetOk("configuration_id"); ok {
+		configurationID = v.(string)
+	}
+	resourceID := BucketNotificationTargetCreateResourceID(bucket, configurationID)
+
+	err := modifyBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		if notificationConfigurationIDExists(config, configurationID) {
+			return fmt.Errorf("notification configuration with ID (%s) already exists", configurationID)
+		}
+
+		addNotificationTargetConfiguration(d, config, configurationID)
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Notification Target (%s): %s", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return append(diags, resourceBucketNotificationTargetRead(ctx, d, meta)...)
+}
+
+func resourceBucketNotificationTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, configurationID, err := BucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindBucketNotificationTargetByTwoPartKey(ctx, conn, bucket, configurationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification Target (%s): %s", d.Id(), err)
+	}
+
+	var events []*string
+	var filter *s3.NotificationConfigurationFilter
+	d.Set("lambda_function_arn", nil)
+	d.Set("queue_arn", nil)
+	d.Set("topic_arn", nil)
+	switch {
+	case len(output.LambdaFunctionConfigurations) > 0:
+		c := output.LambdaFunctionConfigurations[0]
+		events, filter = c.Events, c.Filter
+		d.Set("lambda_function_arn", c.LambdaFunctionArn)
+	case len(output.QueueConfigurations) > 0:
+		c := output.QueueConfigurations[0]
+		events, filter = c.Events, c.Filter
+		d.Set("queue_arn", c.QueueArn)
+	case len(output.TopicConfigurations) > 0:
+		c := output.TopicConfigurations[0]
+		events, filter = c.Events, c.Filter
+		d.Set("topic_arn", c.TopicArn)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("configuration_id", configurationID)
+	d.Set("events", aws.StringValueSlice(events))
+	d.Set("filter_prefix", nil)
+	d.Set("filter_suffix", nil)
+	if filter != nil {
+		for k, v := range flattenNotificationConfigurationFilter(filter) {
+			d.Set(k, v)
+		}
+	}
+
+	return diags
+}
+
+func resourceBucketNotificationTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, configurationID, err := BucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		removeNotificationConfigurationByID(config, configurationID)
+		addNotificationTargetConfiguration(d, config, configurationID)
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Notification Target (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketNotificationTargetRead(ctx, d, meta)...)
+}
+
+func resourceBucketNotificationTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket, configurationID, err := BucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification Target: %s", d.Id())
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(config *s3.NotificationConfiguration) error {
+		removeNotificationConfigurationByID(config, configurationID)
+
+		return nil
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification Target (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const BucketNotificationTargetResourceIDSeparator = ","
+
+func BucketNotificationTargetCreateResourceID(bucket, configurationID string) string {
+	parts := []string{bucket, configurationID}
+	id := strings.Join(parts, BucketNotificationTargetResourceIDSeparator)
+
+	return id
+}
+
+func BucketNotificationTargetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, BucketNotificationTargetResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sCONFIGURATION_ID", id, BucketNotificationTargetResourceIDSeparator)
+}
+
+// modifyBucketNotificationConfiguration performs a read-modify-write of the bucket's notification configuration.
+// The bucket's notification configuration is a single document, so concurrent modifications are serialized per bucket
+// and configurations not touched by f (including EventBridge) are written back unchanged.
+func modifyBucketNotificationConfiguration(ctx context.Context, conn *s3.S3, bucket string, f func(*s3.NotificationConfiguration) error) error {
+	key := "s3-bucket-notification-" + bucket
+	conns.GlobalMutexKV.Lock(key)
+	defer conns.GlobalMutexKV.Unlock(key)
+
+	outputRaw, err := retryWhenBucketNotFound(ctx, func() (interface{}, error) {
+		return conn.GetBucketNotificationConfigurationWithContext(ctx, &s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(bucket),
+		})
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) Notification Configuration: %w", bucket, err)
+	}
+
+	config := outputRaw.(*s3.NotificationConfiguration)
+
+	if err := f(config); err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: config,
+	}
+
+	_, err = retryWhenBucketNotFound(ctx, func() (interface{}, error) {
+		return conn.PutBucketNotificationConfigurationWithContext(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting S3 Bucket (%s) Notification Configuration: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func addNotificationTargetConfiguration(d *schema.ResourceData, config *s3.NotificationConfiguration, configurationID string) {
+	events := flex.ExpandStringSet(d.Get("events").(*schema.Set))
+	filter := expandNotificationConfigurationFilter(d.Get("filter_prefix").(string), d.Get("filter_suffix").(string))
+
+	if v, ok := d.GetOk("lambda_function_arn"); ok {
+		config.LambdaFunctionConfigurations = append(config.LambdaFunctionConfigurations, &s3.LambdaFunctionConfiguration{
+			Events:            events,
+			Filter:            filter,
+			Id:                aws.String(configurationID),
+			LambdaFunctionArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("queue_arn"); ok {
+		config.QueueConfigurations = append(config.QueueConfigurations, &s3.QueueConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       aws.String(configurationID),
+			QueueArn: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("topic_arn"); ok {
+		config.TopicConfigurations = append(config.TopicConfigurations, &s3.TopicConfiguration{
+			Events:   events,
+			Filter:   filter,
+			Id:       aws.String(configurationID),
+			TopicArn: aws.String(v.(string)),
+		})
+	}
+}
+
+func notificationConfigurationIDExists(config *s3.NotificationConfiguration, configurationID string) bool {
+	for _, c := range config.LambdaFunctionConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return true
+		}
+	}
+	for _, c := range config.QueueConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return true
+		}
+	}
+	for _, c := range config.TopicConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeNotificationConfigurationByID(config *s3.NotificationConfiguration, configurationID string) {
+	var lambdaConfigs []*s3.LambdaFunctionConfiguration
+	for _, c := range config.LambdaFunctionConfigurations {
+		if aws.StringValue(c.Id) != configurationID {
+			lambdaConfigs = append(lambdaConfigs, c)
+		}
+	}
+	config.LambdaFunctionConfigurations = lambdaConfigs
+
+	var queueConfigs []*s3.QueueConfiguration
+	for _, c := range config.QueueConfigurations {
+		if aws.StringValue(c.Id) != configurationID {
+			queueConfigs = append(queueConfigs, c)
+		}
+	}
+	config.QueueConfigurations = queueConfigs
+
+	var topicConfigs []*s3.TopicConfiguration
+	for _, c := range config.TopicConfigurations {
+		if aws.StringValue(c.Id) != configurationID {
+			topicConfigs = append(topicConfigs, c)
+		}
+	}
+	config.TopicConfigurations = topicConfigs
+}
+
+func expandNotificationConfigurationFilter(prefix, suffix string) *s3.NotificationConfigurationFilter {
+	filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
+
+	if prefix != "" {
+		filterRules = append(filterRules, &s3.FilterRule{
+			Name:  aws.String("prefix"),
+			Value: aws.String(prefix),
+		})
+	}
+
+	if suffix != "" {
+		filterRules = append(filterRules, &s3.FilterRule{
+			Name:  aws.String("suffix"),
+			Value: aws.String(suffix),
+		})
+	}
+
+	if len(filterRules) == 0 {
+		return nil
+	}
+
+	return &s3.NotificationConfigurationFilter{
+		Key: &s3.KeyFilter{
+			FilterRules: filterRules,
+		},
+	}
+}
+
+// FindBucketNotificationTargetByTwoPartKey returns a notification configuration containing only the
+// lambda function, queue or topic configuration with the specified ID.
+func FindBucketNotificationTargetByTwoPartKey(ctx context.Context, conn *s3.S3, bucket, configurationID string) (*s3.NotificationConfiguration, error) {
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketNotificationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, c := range output.LambdaFunctionConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return &s3.NotificationConfiguration{LambdaFunctionConfigurations: []*s3.LambdaFunctionConfiguration{c}}, nil
+		}
+	}
+	for _, c := range output.QueueConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return &s3.NotificationConfiguration{QueueConfigurations: []*s3.QueueConfiguration{c}}, nil
+		}
+	}
+	for _, c := range output.TopicConfigurations {
+		if aws.StringValue(c.Id) == configurationID {
+			return &s3.NotificationConfiguration{TopicConfigurations: []*s3.TopicConfiguration{c}}, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
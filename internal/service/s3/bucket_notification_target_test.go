@@ -0,0 +1,378 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestBucketNotificationTargetParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectError             bool
+		ExpectedBucket          string
+		ExpectedConfigurationID string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "bucket only",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty configuration ID",
+			InputID:     "example,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "example,id,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:                "valid",
+			InputID:                 tfs3.BucketNotificationTargetCreateResourceID("example", "notification-sqs"),
+			ExpectedBucket:          "example",
+			ExpectedConfigurationID: "notification-sqs",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotBucket, gotConfigurationID, err := tfs3.BucketNotificationTargetParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotBucket != testCase.ExpectedBucket {
+				t.Errorf("got bucket %s, expected %s", gotBucket, testCase.ExpectedBucket)
+			}
+
+			if gotConfigurationID != testCase.ExpectedConfigurationID {
+				t.Errorf("got configuration ID %s, expected %s", gotConfigurationID, testCase.ExpectedConfigurationID)
+			}
+		})
+	}
+}
+
+func TestAccS3BucketNotificationTarget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_queue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_id", "notification-sqs"),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectRemoved:Delete"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".mp4"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "topic_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTarget_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_queue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationTarget(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTarget_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queueResourceName := "aws_s3_bucket_notification_target.queue"
+	topicResourceName := "aws_s3_bucket_notification_target.topic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, queueResourceName),
+					testAccCheckBucketNotificationTargetExists(ctx, topicResourceName),
+					resource.TestCheckResourceAttrPair(queueResourceName, "queue_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttrPair(topicResourceName, "topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				// Removing one target must leave the other in place.
+				Config: testAccBucketNotificationTargetConfig_multipleTopicOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, topicResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTarget_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetConfig_queue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "topic_arn", ""),
+				),
+			},
+			{
+				Config: testAccBucketNotificationTargetConfig_topic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_id", "notification-sqs"),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ""),
+					resource.TestCheckResourceAttr(resourceName, "queue_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_notification_target" {
+				continue
+			}
+
+			bucket, configurationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3.FindBucketNotificationTargetByTwoPartKey(ctx, conn, bucket, configurationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification Target %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationTargetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Notification Target ID is set")
+		}
+
+		bucket, configurationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err = tfs3.FindBucketNotificationTargetByTwoPartKey(ctx, conn, bucket, configurationID)
+
+		return err
+	}
+}
+
+func testAccBucketNotificationTargetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "SNS:Publish",
+      "Resource": "arn:${data.aws_partition.current.partition}:sns:*:*:%[1]s",
+      "Condition": {
+        "ArnLike": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+func testAccBucketNotificationTargetConfig_queue(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), `
+resource "aws_s3_bucket_notification_target" "test" {
+  bucket           = aws_s3_bucket.test.id
+  configuration_id = "notification-sqs"
+  queue_arn        = aws_sqs_queue.test.arn
+
+  events = [
+    "s3:ObjectCreated:*",
+    "s3:ObjectRemoved:Delete",
+  ]
+
+  filter_prefix = "tf-acc-test/"
+  filter_suffix = ".mp4"
+}
+`)
+}
+
+func testAccBucketNotificationTargetConfig_topic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), `
+resource "aws_s3_bucket_notification_target" "test" {
+  bucket           = aws_s3_bucket.test.id
+  configuration_id = "notification-sqs"
+  topic_arn        = aws_sns_topic.test.arn
+  events           = ["s3:ObjectCreated:*"]
+}
+`)
+}
+
+func testAccBucketNotificationTargetConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), `
+resource "aws_s3_bucket_notification_target" "queue" {
+  bucket        = aws_s3_bucket.test.id
+  queue_arn     = aws_sqs_queue.test.arn
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = "queue/"
+}
+
+resource "aws_s3_bucket_notification_target" "topic" {
+  bucket        = aws_s3_bucket.test.id
+  topic_arn     = aws_sns_topic.test.arn
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = "topic/"
+}
+`)
+}
+
+func testAccBucketNotificationTargetConfig_multipleTopicOnly(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationTargetConfig_base(rName), `
+resource "aws_s3_bucket_notification_target" "topic" {
+  bucket        = aws_s3_bucket.test.id
+  topic_arn     = aws_sns_topic.test.arn
+  events        = ["s3:ObjectCreated:*"]
+  filter_prefix = "topic/"
+}
+`)
+}
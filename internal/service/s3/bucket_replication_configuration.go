@@ -3,6 +3,7 @@ package s3
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -53,9 +54,10 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"delete_marker_replication": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDisabledConfigurationBlock("delete_marker_replication"),
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"status": {
@@ -111,9 +113,10 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 										},
 									},
 									"metrics": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
+										Type:             schema.TypeList,
+										Optional:         true,
+										MaxItems:         1,
+										DiffSuppressFunc: suppressDisabledConfigurationBlock("metrics"),
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"event_threshold": {
@@ -181,9 +184,10 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 							},
 						},
 						"existing_object_replication": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDisabledConfigurationBlock("existing_object_replication"),
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"status": {
@@ -257,15 +261,17 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 							Optional: true,
 						},
 						"source_selection_criteria": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							DiffSuppressFunc: suppressDisabledConfigurationBlock("source_selection_criteria"),
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"replica_modifications": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
+										Type:             schema.TypeList,
+										Optional:         true,
+										MaxItems:         1,
+										DiffSuppressFunc: suppressDisabledConfigurationBlock("replica_modifications"),
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"status": {
@@ -277,9 +283,10 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 										},
 									},
 									"sse_kms_encrypted_objects": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
+										Type:             schema.TypeList,
+										Optional:         true,
+										MaxItems:         1,
+										DiffSuppressFunc: suppressDisabledConfigurationBlock("sse_kms_encrypted_objects"),
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"status": {
@@ -382,7 +389,7 @@ func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.R
 
 	d.Set("bucket", d.Id())
 	d.Set("role", r.Role)
-	if err := d.Set("rule", FlattenReplicationRules(ctx, r.Rules)); err != nil {
+	if err := d.Set("rule", sortReplicationRules(FlattenReplicationRules(ctx, r.Rules), d.Get("rule").([]interface{}))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 
@@ -449,3 +456,53 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 
 	return diags
 }
+
+// suppressDisabledConfigurationBlock suppresses the removal of the named optional configuration block
+// when it is absent from configuration but S3 returns it with every status set to Disabled.
+func suppressDisabledConfigurationBlock(name string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		i := strings.LastIndex(k, name+".")
+
+		if i < 0 || (new != "" && new != "0") {
+			return false
+		}
+
+		o, n := d.GetChange(k[:i+len(name)])
+
+		if v, ok := n.([]interface{}); ok && len(v) > 0 {
+			return false
+		}
+
+		v, ok := o.([]interface{})
+
+		return ok && len(v) > 0 && allStatusesDisabled(v)
+	}
+}
+
+func allStatusesDisabled(v interface{}) bool {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			if !allStatusesDisabled(v) {
+				return false
+			}
+		}
+	case map[string]interface{}:
+		for k, v := range v {
+			if k == "status" {
+				// All replication statuses share the same Disabled value.
+				if v, ok := v.(string); ok && v != "" && v != s3.ReplicationRuleStatusDisabled {
+					return false
+				}
+
+				continue
+			}
+
+			if !allStatusesDisabled(v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
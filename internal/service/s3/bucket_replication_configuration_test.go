@@ -550,6 +550,52 @@ func TestAccS3BucketReplicationConfiguration_replicaModifications(t *testing.T)
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_twoWayReplication(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	reverseResourceName := "aws_s3_bucket_replication_configuration.reverse"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_twoWay(rName, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckBucketReplicationConfigurationExists(ctx, reverseResourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "replicate-all"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.source_selection_criteria.0.replica_modifications.0.status", s3.ReplicaModificationsStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.metrics.0.status", s3.MetricsStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.metrics.0.event_threshold.0.minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.replication_time.0.time.0.minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "archive"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.0.and.0.prefix", "archive/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.0.and.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(reverseResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(reverseResourceName, "rule.0.id", "replicate-all"),
+					resource.TestCheckResourceAttr(reverseResourceName, "rule.0.source_selection_criteria.0.replica_modifications.0.status", s3.ReplicaModificationsStatusEnabled),
+				),
+			},
+			{
+				// Rules, metrics and replica modifications must round-trip without a diff.
+				Config:   testAccBucketReplicationConfigurationConfig_twoWay(rName, rNameDestination),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // TestAccS3BucketReplicationConfiguration_withoutId ensures a configuration with a Computed
 // rule.id does not result in a non-empty plan
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23690
@@ -2442,3 +2488,175 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`,
 	)
 }
+
+func testAccBucketReplicationConfigurationConfig_twoWay(rName, rNameDestination string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id       = "replicate-all"
+    priority = 2
+    status   = "Enabled"
+
+    filter {}
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    source_selection_criteria {
+      replica_modifications {
+        status = "Enabled"
+      }
+    }
+
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+
+      metrics {
+        status = "Enabled"
+
+        event_threshold {
+          minutes = 15
+        }
+      }
+
+      replication_time {
+        status = "Enabled"
+
+        time {
+          minutes = 15
+        }
+      }
+    }
+  }
+
+  rule {
+    id       = "archive"
+    priority = 1
+    status   = "Enabled"
+
+    filter {
+      and {
+        prefix = "archive/"
+
+        tags = {
+          Archive = "true"
+        }
+      }
+    }
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = "GLACIER"
+    }
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "reverse" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.destination.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id       = "replicate-all"
+    priority = 2
+    status   = "Enabled"
+
+    filter {}
+
+    delete_marker_replication {
+      status = "Enabled"
+    }
+
+    source_selection_criteria {
+      replica_modifications {
+        status = "Enabled"
+      }
+    }
+
+    destination {
+      bucket = aws_s3_bucket.source.arn
+    }
+  }
+
+  rule {
+    id       = "archive"
+    priority = 1
+    status   = "Enabled"
+
+    filter {
+      prefix = "archive/"
+    }
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.source.arn
+      storage_class = "GLACIER"
+    }
+  }
+}
+`, rName, rNameDestination)
+}
@@ -235,6 +235,35 @@ func TestAccS3Bucket_Basic_forceDestroyWithObjectLockEnabled(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Basic_forceDestroyParallelism(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_bucket.test"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	// More than two pages of ListObjectVersions results.
+	keys := make([]string, 2001)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("prefix/data-%d.txt", i)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyParallelism(bucketName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_parallelism", "5"),
+					testAccCheckBucketAddObjects(ctx, resourceName, keys...),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Bucket_Basic_acceleration(t *testing.T) {
 	ctx := acctest.Context(t)
 	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
@@ -4316,6 +4345,16 @@ resource "aws_s3_bucket" "test" {
 `, bucketName)
 }
 
+func testAccBucketConfig_forceDestroyParallelism(bucketName string, parallelism int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket                    = %[1]q
+  force_destroy             = true
+  force_destroy_parallelism = %[2]d
+}
+`, bucketName, parallelism)
+}
+
 func testAccBucketConfig_forceDestroyObjectLockEnabled(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
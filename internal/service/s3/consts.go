@@ -3,3 +3,10 @@ package s3
 const (
 	filterRulesSliceStartLen = 2
 )
+
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/intelligent-tiering-overview.html#intel-tiering-tier-definition
+const (
+	intelligentTieringArchiveAccessMinDays     = 90
+	intelligentTieringDeepArchiveAccessMinDays = 180
+	intelligentTieringMaxDays                  = 730
+)
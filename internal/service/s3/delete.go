@@ -3,6 +3,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -14,9 +15,10 @@ import (
 // EmptyBucket empties the specified S3 bucket by deleting all object versions and delete markers.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
+// Up to `parallelism` pages (<= 1000 objects each) of object versions are deleted concurrently.
 // Returns the number of objects deleted.
-func EmptyBucket(ctx context.Context, conn *s3.S3, bucket string, force bool) (int64, error) {
-	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
+func EmptyBucket(ctx context.Context, conn *s3.S3, bucket string, force bool, parallelism int) (int64, error) {
+	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, parallelism, func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
 		return deletePageOfObjectVersions(ctx, conn, bucket, force, page)
 	})
 
@@ -24,38 +26,72 @@ func EmptyBucket(ctx context.Context, conn *s3.S3, bucket string, force bool) (i
 		return nObjects, err
 	}
 
-	n, err := forEachObjectVersionsPage(ctx, conn, bucket, deletePageOfDeleteMarkers)
+	n, err := forEachObjectVersionsPage(ctx, conn, bucket, parallelism, deletePageOfDeleteMarkers)
 	nObjects += n
 
 	return nObjects, err
 }
 
 // forEachObjectVersionsPage calls the specified function for each page returned from the S3 ListObjectVersionsPages API.
-func forEachObjectVersionsPage(ctx context.Context, conn *s3.S3, bucket string, fn func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
-	var nObjects int64
+// At most `parallelism` calls run concurrently. Listing stops at the first error returned by the function.
+func forEachObjectVersionsPage(ctx context.Context, conn *s3.S3, bucket string, parallelism int, fn func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		nObjects int64
+		lastErr  error
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, parallelism)
 
 	input := &s3.ListObjectVersionsInput{
 		Bucket: aws.String(bucket),
 	}
-	var lastErr error
 
 	err := conn.ListObjectVersionsPagesWithContext(ctx, input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		n, err := fn(ctx, conn, bucket, page)
-		nObjects += n
+		sem <- struct{}{}
 
-		if err != nil {
-			lastErr = err
+		mu.Lock()
+		failed := lastErr != nil
+		mu.Unlock()
+
+		if failed {
+			<-sem
 
 			return false
 		}
 
+		wg.Add(1)
+		go func(page *s3.ListObjectVersionsOutput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			n, err := fn(ctx, conn, bucket, page)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			nObjects += n
+
+			if err != nil {
+				lastErr = err
+			}
+		}(page)
+
 		return !lastPage
 	})
 
+	wg.Wait()
+
 	if err != nil {
 		return nObjects, fmt.Errorf("listing S3 Bucket (%s) object versions: %w", bucket, err)
 	}
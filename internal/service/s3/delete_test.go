@@ -10,10 +10,11 @@ import (
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 )
 
-// AWS_REGION=us-west-2 go test -v ./internal/service/s3 -run=TestEmptyBucket -b ewbankkit-test-empty-bucket-001 -f
+// AWS_REGION=us-west-2 go test -v ./internal/service/s3 -run=TestEmptyBucket -b ewbankkit-test-empty-bucket-001 -f -w 10
 
 var bucket = flag.String("b", "", "bucket")
 var force = flag.Bool("f", false, "force")
+var parallelism = flag.Int("w", 1, "parallelism")
 
 func TestEmptyBucket(t *testing.T) {
 	t.Parallel()
@@ -27,7 +28,7 @@ func TestEmptyBucket(t *testing.T) {
 	sess := session.Must(session.NewSession())
 	svc := s3.New(sess)
 
-	n, err := tfs3.EmptyBucket(ctx, svc, *bucket, *force)
+	n, err := tfs3.EmptyBucket(ctx, svc, *bucket, *force, *parallelism)
 
 	if err != nil {
 		t.Fatalf("error emptying S3 bucket (%s): %s", *bucket, err)
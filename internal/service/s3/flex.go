@@ -3,6 +3,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -1180,6 +1181,40 @@ func FlattenReplicationRules(ctx context.Context, rules []*s3.ReplicationRule) [
 	return results
 }
 
+// sortReplicationRules orders rules returned by S3 to match the order of the rules with the same ID in state,
+// as S3 does not preserve the order of rules. Rules without a match keep their relative order and come last.
+func sortReplicationRules(rules, stateRules []interface{}) []interface{} {
+	indexes := make(map[string]int, len(stateRules))
+	for i, v := range stateRules {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if id, ok := tfMap["id"].(string); ok && id != "" {
+				indexes[id] = i
+			}
+		}
+	}
+
+	sorted := make([]interface{}, len(rules))
+	copy(sorted, rules)
+
+	index := func(v interface{}) int {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if id, ok := tfMap["id"].(string); ok {
+				if i, ok := indexes[id]; ok {
+					return i
+				}
+			}
+		}
+
+		return len(stateRules)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return index(sorted[i]) < index(sorted[j])
+	})
+
+	return sorted
+}
+
 func FlattenSourceSelectionCriteriaReplicaModifications(rc *s3.ReplicaModifications) []interface{} {
 	if rc == nil {
 		return []interface{}{}
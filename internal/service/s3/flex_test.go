@@ -73,3 +73,103 @@ func TestFlattenReplicationRuleFilterTag(t *testing.T) {
 		t.Fatalf("Expected 'value' to equal %s, got %s", expectedValue, actualValue)
 	}
 }
+
+func TestSortReplicationRules(t *testing.T) {
+	t.Parallel()
+
+	rule := func(id string) interface{} {
+		return map[string]interface{}{"id": id}
+	}
+
+	testCases := []struct {
+		Name       string
+		Rules      []interface{}
+		StateRules []interface{}
+		Expected   []string
+	}{
+		{
+			Name:     "no state",
+			Rules:    []interface{}{rule("b"), rule("a")},
+			Expected: []string{"b", "a"},
+		},
+		{
+			Name:       "state order",
+			Rules:      []interface{}{rule("a"), rule("c"), rule("b")},
+			StateRules: []interface{}{rule("c"), rule("b"), rule("a")},
+			Expected:   []string{"c", "b", "a"},
+		},
+		{
+			Name:       "new rules last",
+			Rules:      []interface{}{rule("x"), rule("a"), rule("y"), rule("b")},
+			StateRules: []interface{}{rule("b"), rule("a")},
+			Expected:   []string{"b", "a", "x", "y"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			result := sortReplicationRules(testCase.Rules, testCase.StateRules)
+
+			if len(result) != len(testCase.Expected) {
+				t.Fatalf("Expected %d rules, got %d", len(testCase.Expected), len(result))
+			}
+
+			for i, v := range result {
+				if id := v.(map[string]interface{})["id"]; id != testCase.Expected[i] {
+					t.Errorf("Expected rule %d to have ID %s, got %s", i, testCase.Expected[i], id)
+				}
+			}
+		})
+	}
+}
+
+func TestAllStatusesDisabled(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Value    interface{}
+		Expected bool
+	}{
+		{
+			Name:     "disabled",
+			Value:    []interface{}{map[string]interface{}{"status": s3.DeleteMarkerReplicationStatusDisabled}},
+			Expected: true,
+		},
+		{
+			Name:     "enabled",
+			Value:    []interface{}{map[string]interface{}{"status": s3.DeleteMarkerReplicationStatusEnabled}},
+			Expected: false,
+		},
+		{
+			Name: "nested disabled",
+			Value: []interface{}{map[string]interface{}{
+				"replica_modifications":     []interface{}{map[string]interface{}{"status": s3.ReplicaModificationsStatusDisabled}},
+				"sse_kms_encrypted_objects": []interface{}{},
+			}},
+			Expected: true,
+		},
+		{
+			Name: "nested enabled",
+			Value: []interface{}{map[string]interface{}{
+				"replica_modifications":     []interface{}{map[string]interface{}{"status": s3.ReplicaModificationsStatusDisabled}},
+				"sse_kms_encrypted_objects": []interface{}{map[string]interface{}{"status": s3.SseKmsEncryptedObjectsStatusEnabled}},
+			}},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := allStatusesDisabled(testCase.Value); got != testCase.Expected {
+				t.Errorf("Expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
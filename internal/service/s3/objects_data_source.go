@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -63,6 +64,16 @@ func DataSourceObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"request_payer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.RequestPayer_Values(), false),
+			},
+			"storage_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -106,9 +117,14 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 		listInput.FetchOwner = aws.Bool(b.(bool))
 	}
 
+	if s, ok := d.GetOk("request_payer"); ok {
+		listInput.RequestPayer = aws.String(s.(string))
+	}
+
 	var commonPrefixes []string
 	var keys []string
 	var owners []string
+	var storageClasses []string
 
 	err := conn.ListObjectsV2PagesWithContext(ctx, &listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
@@ -121,10 +137,18 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
+
+			storageClasses = append(storageClasses, aws.StringValue(object.StorageClass))
 		}
 
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
+		// Stop once "max_keys" keys have been returned; a MaxKeys of 0 would
+		// otherwise keep requesting empty pages of a truncated listing.
+		if maxKeys <= 0 {
+			return false
+		}
+
 		if maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
@@ -150,5 +174,9 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting owners: %s", err)
 	}
 
+	if err := d.Set("storage_classes", storageClasses); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting storage_classes: %s", err)
+	}
+
 	return diags
 }
@@ -234,6 +234,60 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectsDataSource_requestPayer(t *testing.T) {
+	ctx := acctest.Context(t)
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_resources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsDataSourceConfig_requestPayer(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource("data.aws_s3_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "storage_classes.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "storage_classes.0", s3.ObjectStorageClassStandard),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "storage_classes.1", s3.ObjectStorageClassStandard),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_maxKeysPaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_manyObjects(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsDataSourceConfig_maxKeysPaged(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.#", "1001"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "storage_classes.#", "1001"),
+					resource.TestCheckResourceAttr("data.aws_s3_objects.yesh", "keys.1000", "data/1000"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectsExistsDataSource(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -409,3 +463,42 @@ data "aws_s3_objects" "yesh" {
 }
 `, testAccObjectsDataSourceConfig_resources(randInt))
 }
+
+func testAccObjectsDataSourceConfig_requestPayer(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket        = aws_s3_bucket.objects_bucket.id
+  prefix        = "arch/three_gossips/"
+  request_payer = "requester"
+}
+`, testAccObjectsDataSourceConfig_resources(randInt))
+}
+
+func testAccObjectsDataSourceConfig_manyObjects(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-acc-objects-test-bucket-%[1]d"
+}
+
+resource "aws_s3_object" "test" {
+  count = 1002
+
+  bucket  = aws_s3_bucket.objects_bucket.id
+  key     = format("data/%%04d", count.index)
+  content = "x"
+}
+`, randInt)
+}
+
+func testAccObjectsDataSourceConfig_maxKeysPaged(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket   = aws_s3_bucket.objects_bucket.id
+  max_keys = 1001
+}
+`, testAccObjectsDataSourceConfig_manyObjects(randInt))
+}
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceBucket,
 			TypeName: "aws_s3_bucket",
 		},
+		{
+			Factory:  DataSourceBucketIntelligentTieringConfigurations,
+			TypeName: "aws_s3_bucket_intelligent_tiering_configurations",
+		},
 		{
 			Factory:  DataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -100,6 +104,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceBucketNotification,
 			TypeName: "aws_s3_bucket_notification",
 		},
+		{
+			Factory:  ResourceBucketNotificationTarget,
+			TypeName: "aws_s3_bucket_notification_target",
+		},
 		{
 			Factory:  ResourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -7,3 +7,7 @@ import (
 const (
 	PropagationTimeout = 2 * time.Minute
 )
+
+const (
+	secretVersionStageCurrent = "AWSCURRENT"
+)
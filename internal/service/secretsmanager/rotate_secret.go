@@ -0,0 +1,139 @@
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Rotate Secret")
+func newResourceRotateSecret(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceRotateSecret{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceRotateSecret struct {
+	framework.ResourceWithAction
+	framework.WithTimeouts
+}
+
+func (r *resourceRotateSecret) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_rotate_secret"
+}
+
+func (r *resourceRotateSecret) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                framework.IDAttribute(),
+			"idempotency_token": framework.ActionIdempotencyTokenAttribute(),
+			"secret_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": framework.ActionTriggersAttribute(),
+			"version_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceRotateSecret) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceRotateSecretData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecretsManagerConn()
+
+	secretID := data.SecretID.ValueString()
+	data.IdempotencyToken = framework.ActionIdempotencyToken(data.IdempotencyToken)
+	input := &secretsmanager.RotateSecretInput{
+		ClientRequestToken: aws.String(data.IdempotencyToken.ValueString()),
+		RotateImmediately:  aws.Bool(true),
+		SecretId:           aws.String(secretID),
+	}
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	// InvalidRequestException: A previous rotation isn't complete. That rotation will be reattempted.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, createTimeout, func() (interface{}, error) {
+		return conn.RotateSecretWithContext(ctx, input)
+	}, secretsmanager.ErrCodeInvalidRequestException, "previous rotation isn")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("rotating Secrets Manager Secret (%s)", secretID), err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*secretsmanager.RotateSecretOutput)
+	versionID := aws.StringValue(output.VersionId)
+
+	if err := waitSecretVersionCurrent(ctx, conn, secretID, versionID, createTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Secrets Manager Secret (%s) version (%s) to become current", secretID, versionID), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(aws.StringValue(output.ARN))
+	data.VersionID = types.StringValue(versionID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// waitSecretVersionCurrent waits until the specified secret version has the AWSCURRENT staging label, i.e. rotation has completed.
+func waitSecretVersionCurrent(ctx context.Context, conn *secretsmanager.SecretsManager, secretID, versionID string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := FindSecretByID(ctx, conn, secretID)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range output.VersionIdsToStages[versionID] {
+			if aws.StringValue(v) == secretVersionStageCurrent {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}, tfresource.WaitOpts{
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+}
+
+type resourceRotateSecretData struct {
+	ID               types.String   `tfsdk:"id"`
+	IdempotencyToken types.String   `tfsdk:"idempotency_token"`
+	SecretID         types.String   `tfsdk:"secret_id"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Triggers         types.Map      `tfsdk:"triggers"`
+	VersionID        types.String   `tfsdk:"version_id"`
+}
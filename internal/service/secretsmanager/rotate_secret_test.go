@@ -0,0 +1,159 @@
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSecretsManagerRotateSecret_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_rotate_secret.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+	var versionID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretConfig_basic(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", secretResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "idempotency_token"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", secretResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					testAccCheckRotateSecretVersionID(resourceName, &versionID, false),
+				),
+			},
+			{
+				Config:   testAccRotateSecretConfig_basic(rName, "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccRotateSecretConfig_basic(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+					testAccCheckRotateSecretVersionID(resourceName, &versionID, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRotateSecretVersionID records the rotated secret version and, if changed is true, checks that it differs from the previously recorded version.
+func testAccCheckRotateSecretVersionID(n string, v *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		versionID := rs.Primary.Attributes["version_id"]
+
+		if changed && versionID == *v {
+			return fmt.Errorf("Secrets Manager Secret (%s) was not rotated again, version: %s", rs.Primary.ID, versionID)
+		}
+
+		*v = versionID
+
+		return nil
+	}
+}
+
+func testAccRotateSecretConfig_basic(rName, trigger string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["lambda.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetRandomPassword",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:PutSecretValue",
+        "secretsmanager:UpdateSecretVersionStage",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/rotation_lambda.zip"
+  function_name = %[1]q
+  handler       = "index.handler"
+  role          = aws_iam_role.test.arn
+  runtime       = "python3.10"
+  timeout       = 30
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "initial"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+
+  rotation_rules {
+    automatically_after_days = 30
+  }
+
+  depends_on = [aws_lambda_permission.test, aws_secretsmanager_secret_version.test]
+}
+
+resource "aws_secretsmanager_rotate_secret" "test" {
+  secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+
+  triggers = {
+    rotation = %[2]q
+  }
+}
+`, rName, trigger)
+}
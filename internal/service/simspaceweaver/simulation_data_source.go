@@ -0,0 +1,162 @@
+package simspaceweaver
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_simspaceweaver_simulation", name="Simulation")
+func DataSourceSimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"maximum_duration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_s3_location": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"target_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	output, err := FindSimulationByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SimSpace Weaver Simulation (%s): %s", name, err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.SetId(aws.StringValue(output.Name))
+	d.Set("arn", arn)
+	if output.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(output.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("execution_id", output.ExecutionId)
+	d.Set("maximum_duration", output.MaximumDuration)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("schema_error", output.SchemaError)
+	if output.SchemaS3Location != nil {
+		if err := d.Set("schema_s3_location", []interface{}{map[string]interface{}{
+			"bucket_name": aws.StringValue(output.SchemaS3Location.BucketName),
+			"object_key":  aws.StringValue(output.SchemaS3Location.ObjectKey),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting schema_s3_location: %s", err)
+		}
+	} else {
+		d.Set("schema_s3_location", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("target_status", output.TargetStatus)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SimSpace Weaver Simulation (%s): %s", arn, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func FindSimulationByName(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) (*simspaceweaver.DescribeSimulationOutput, error) {
+	input := &simspaceweaver.DescribeSimulationInput{
+		Simulation: aws.String(name),
+	}
+
+	output, err := conn.DescribeSimulationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,50 @@
+package simspaceweaver_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	simulationNameKey       = "TF_AWS_SIMSPACEWEAVER_SIMULATION_NAME"
+	envVarSimulationNameErr = "Name of an existing SimSpace Weaver simulation."
+)
+
+func TestAccSimSpaceWeaverSimulationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := envvar.SkipIfEmpty(t, simulationNameKey, envVarSimulationNameErr)
+	dataSourceName := "data.aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, simspaceweaver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "arn", "simspaceweaver", regexp.MustCompile(`simulation/.+`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "creation_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "role_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "schema_s3_location.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSimulationDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+data "aws_simspaceweaver_simulation" "test" {
+  name = %[1]q
+}
+`, name)
+}
@@ -87,3 +87,74 @@ func FindServiceSettingByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm
 
 	return output.ServiceSetting, nil
 }
+
+func FindOpsItemByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.OpsItem, error) {
+	input := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	output, err := conn.GetOpsItemWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OpsItem, nil
+}
+
+// FindOpsMetadataByARN returns the OpsMetadata object with the specified ARN, with all pages of metadata merged.
+func FindOpsMetadataByARN(ctx context.Context, conn *ssm.SSM, arn string) (*ssm.GetOpsMetadataOutput, error) {
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: aws.String(arn),
+	}
+	var result *ssm.GetOpsMetadataOutput
+
+	for {
+		output, err := conn.GetOpsMetadataWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if result == nil {
+			result = &ssm.GetOpsMetadataOutput{
+				Metadata:   make(map[string]*ssm.MetadataValue),
+				ResourceId: output.ResourceId,
+			}
+		}
+
+		for k, v := range output.Metadata {
+			result.Metadata[k] = v
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
@@ -0,0 +1,426 @@
+package ssm
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_ops_item", name="OpsItem")
+// @Tags(identifierAttribute="id", resourceType="OpsItem")
+func ResourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsItemCreate,
+		ReadWithoutTimeout:   resourceOpsItemRead,
+		UpdateWithoutTimeout: resourceOpsItemUpdate,
+		DeleteWithoutTimeout: resourceOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.OpsItemDataTypeSearchableString,
+							ValidateFunc: validation.StringInSlice(ssm.OpsItemDataType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ops_item_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.OpsItemStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	title := d.Get("title").(string)
+	input := &ssm.CreateOpsItemInput{
+		Description: aws.String(d.Get("description").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+		Tags:        GetTagsIn(ctx),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.Notifications = expandOpsItemNotifications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("operational_data"); ok && v.(*schema.Set).Len() > 0 {
+		input.OperationalData = expandOpsItemOperationalData(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ops_item_type"); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("related_ops_item_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.RelatedOpsItems = expandRelatedOpsItems(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateOpsItemWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s): %s", title, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// The status of a new OpsItem is always Open.
+	if v, ok := d.GetOk("status"); ok && v.(string) != ssm.OpsItemStatusOpen {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v.(string)),
+		}
+
+		if _, err := conn.UpdateOpsItemWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	opsItem, err := FindOpsItemByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ssm.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "opsitem/" + d.Id(),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("category", opsItem.Category)
+	d.Set("created_by", opsItem.CreatedBy)
+	d.Set("created_time", aws.TimeValue(opsItem.CreatedTime).Format(time.RFC3339))
+	d.Set("description", opsItem.Description)
+	d.Set("last_modified_time", aws.TimeValue(opsItem.LastModifiedTime).Format(time.RFC3339))
+	d.Set("notification_arns", flattenOpsItemNotifications(opsItem.Notifications))
+	if err := d.Set("operational_data", flattenOpsItemOperationalData(opsItem.OperationalData)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting operational_data: %s", err)
+	}
+	d.Set("ops_item_type", opsItem.OpsItemType)
+	d.Set("priority", opsItem.Priority)
+	d.Set("related_ops_item_ids", flattenRelatedOpsItems(opsItem.RelatedOpsItems))
+	d.Set("severity", opsItem.Severity)
+	d.Set("source", opsItem.Source)
+	d.Set("status", opsItem.Status)
+	d.Set("title", opsItem.Title)
+
+	return diags
+}
+
+func resourceOpsItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("category") {
+			input.Category = aws.String(d.Get("category").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("notification_arns") {
+			input.Notifications = expandOpsItemNotifications(d.Get("notification_arns").(*schema.Set).List())
+		}
+
+		if d.HasChange("operational_data") {
+			o, n := d.GetChange("operational_data")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			input.OperationalData = expandOpsItemOperationalData(ns.List())
+
+			newKeys := make(map[string]struct{})
+			for _, tfMapRaw := range ns.List() {
+				newKeys[tfMapRaw.(map[string]interface{})["key"].(string)] = struct{}{}
+			}
+
+			for _, tfMapRaw := range os.List() {
+				key := tfMapRaw.(map[string]interface{})["key"].(string)
+
+				if _, ok := newKeys[key]; !ok {
+					input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(key))
+				}
+			}
+		}
+
+		if d.HasChange("priority") {
+			input.Priority = aws.Int64(int64(d.Get("priority").(int)))
+		}
+
+		if d.HasChange("related_ops_item_ids") {
+			input.RelatedOpsItems = expandRelatedOpsItems(d.Get("related_ops_item_ids").(*schema.Set).List())
+		}
+
+		if d.HasChange("severity") {
+			input.Severity = aws.String(d.Get("severity").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		_, err := conn.UpdateOpsItemWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[DEBUG] Deleting SSM OpsItem: %s", d.Id())
+	_, err := conn.DeleteOpsItemWithContext(ctx, &ssm.DeleteOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandOpsItemNotifications(tfList []interface{}) []*ssm.OpsItemNotification {
+	apiObjects := make([]*ssm.OpsItemNotification, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.OpsItemNotification{
+			Arn: v,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemNotifications(apiObjects []*ssm.OpsItemNotification) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Arn))
+	}
+
+	return tfList
+}
+
+func expandOpsItemOperationalData(tfList []interface{}) map[string]*ssm.OpsItemDataValue {
+	apiObjects := make(map[string]*ssm.OpsItemDataValue, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemOperationalData(apiObjects map[string]*ssm.OpsItemDataValue) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandRelatedOpsItems(tfList []interface{}) []*ssm.RelatedOpsItem {
+	apiObjects := make([]*ssm.RelatedOpsItem, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.RelatedOpsItem{
+			OpsItemId: v,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRelatedOpsItems(apiObjects []*ssm.RelatedOpsItem) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.OpsItemId))
+	}
+
+	return tfList
+}
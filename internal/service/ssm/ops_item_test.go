@@ -0,0 +1,305 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_type", "/aws/issue"),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceOpsItem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_full(rName, "Availability", "2", 3, "key1", ssm.OpsItemStatusOpen),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category", "Availability"),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_arns.0", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "key1",
+						"type":  ssm.OpsItemDataTypeSearchableString,
+						"value": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "related_ops_item_ids.0", "aws_ssm_ops_item.related", "id"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_full(rName, "Performance", "3", 4, "key2", ssm.OpsItemStatusResolved),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category", "Performance"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "key2",
+						"type":  ssm.OpsItemDataTypeSearchableString,
+						"value": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "4"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusResolved),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemExists(ctx context.Context, n string, v *ssm.OpsItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		output, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOpsItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_ops_item" {
+				continue
+			}
+
+			_, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM OpsItem %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOpsItemConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Test"
+  source      = "terraform"
+}
+`, rName)
+}
+
+func testAccOpsItemConfig_full(rName, category, severity string, priority int, dataKey, status string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssm_ops_item" "related" {
+  title       = "%[1]s-related"
+  description = "Related"
+  source      = "terraform"
+}
+
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Test"
+  source      = "terraform"
+  category    = %[2]q
+  severity    = %[3]q
+  priority    = %[4]d
+  status      = %[6]q
+
+  notification_arns    = [aws_sns_topic.test.arn]
+  related_ops_item_ids = [aws_ssm_ops_item.related.id]
+
+  operational_data {
+    key   = %[5]q
+    value = %[1]q
+  }
+}
+`, rName, category, severity, priority, dataKey, status)
+}
+
+func testAccOpsItemConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
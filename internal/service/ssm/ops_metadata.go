@@ -0,0 +1,237 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_ops_metadata", name="OpsMetadata")
+// @Tags
+func ResourceOpsMetadata() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsMetadataCreate,
+		ReadWithoutTimeout:   resourceOpsMetadataRead,
+		UpdateWithoutTimeout: resourceOpsMetadataUpdate,
+		DeleteWithoutTimeout: resourceOpsMetadataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4096),
+				},
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsMetadataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	resourceID := d.Get("resource_id").(string)
+	input := &ssm.CreateOpsMetadataInput{
+		ResourceId: aws.String(resourceID),
+		Tags:       GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Metadata = expandOpsMetadataMetadata(v.(map[string]interface{}))
+	}
+
+	output, err := conn.CreateOpsMetadataWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsMetadata (%s): %s", resourceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsMetadataArn))
+
+	return append(diags, resourceOpsMetadataRead(ctx, d, meta)...)
+}
+
+func resourceOpsMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	output, err := FindOpsMetadataByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsMetadata %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsMetadata (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("metadata", flattenOpsMetadataMetadata(output.Metadata))
+	d.Set("resource_id", output.ResourceId)
+
+	tagResourceID, err := opsMetadataTagResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tags, err := ListTags(ctx, conn, tagResourceID, ssm.ResourceTypeForTaggingOpsMetadata)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SSM OpsMetadata (%s): %s", d.Id(), err)
+	}
+
+	SetTagsOut(ctx, Tags(tags))
+
+	return diags
+}
+
+func resourceOpsMetadataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		om, nm := o.(map[string]interface{}), n.(map[string]interface{})
+		input := &ssm.UpdateOpsMetadataInput{
+			OpsMetadataArn: aws.String(d.Id()),
+		}
+
+		for k := range om {
+			if _, ok := nm[k]; !ok {
+				input.KeysToDelete = append(input.KeysToDelete, aws.String(k))
+			}
+		}
+
+		updated := make(map[string]interface{})
+		for k, v := range nm {
+			if ov, ok := om[k]; !ok || ov != v {
+				updated[k] = v
+			}
+		}
+
+		if len(updated) > 0 {
+			input.MetadataToUpdate = expandOpsMetadataMetadata(updated)
+		}
+
+		_, err := conn.UpdateOpsMetadataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsMetadata (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		tagResourceID, err := opsMetadataTagResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, tagResourceID, ssm.ResourceTypeForTaggingOpsMetadata, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsMetadata (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsMetadataRead(ctx, d, meta)...)
+}
+
+func resourceOpsMetadataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[DEBUG] Deleting SSM OpsMetadata: %s", d.Id())
+	_, err := conn.DeleteOpsMetadataWithContext(ctx, &ssm.DeleteOpsMetadataInput{
+		OpsMetadataArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM OpsMetadata (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// opsMetadataTagResourceID returns the ID used to tag an OpsMetadata object, the part of its ARN following "opsmetadata".
+func opsMetadataTagResourceID(opsMetadataARN string) (string, error) {
+	parsedARN, err := arn.Parse(opsMetadataARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(parsedARN.Resource, "opsmetadata/") {
+		return "", fmt.Errorf("unexpected format for OpsMetadata ARN (%s)", opsMetadataARN)
+	}
+
+	return strings.TrimPrefix(parsedARN.Resource, "opsmetadata"), nil
+}
+
+func expandOpsMetadataMetadata(tfMap map[string]interface{}) map[string]*ssm.MetadataValue {
+	apiObjects := make(map[string]*ssm.MetadataValue, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects[k] = &ssm.MetadataValue{
+			Value: aws.String(v.(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenOpsMetadataMetadata(apiObjects map[string]*ssm.MetadataValue) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[k] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
@@ -90,6 +90,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMaintenanceWindowTask,
 			TypeName: "aws_ssm_maintenance_window_task",
 		},
+		{
+			Factory:  ResourceOpsItem,
+			TypeName: "aws_ssm_ops_item",
+			Name:     "OpsItem",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+				ResourceType:        "OpsItem",
+			},
+		},
+		{
+			Factory:  ResourceOpsMetadata,
+			TypeName: "aws_ssm_ops_metadata",
+			Name:     "OpsMetadata",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  ResourceParameter,
 			TypeName: "aws_ssm_parameter",
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -98,7 +99,10 @@ func ResourceReplicationSet() *schema.Resource {
 			StateContext: resourceReplicationSetImport,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceReplicationSetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -275,6 +279,25 @@ func updateRegionsInput(d *schema.ResourceData, input *ssmincidents.UpdateReplic
 	return nil
 }
 
+// Regions can be added to or removed from a Replication Set in place, but the encryption of an existing region cannot be changed.
+func resourceReplicationSetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("region") {
+		return nil
+	}
+
+	old, new := d.GetChange("region")
+	oldRegions := regionListToRegionMap(old.(*schema.Set).List())
+	newRegions := regionListToRegionMap(new.(*schema.Set).List())
+
+	for region, oldcmk := range oldRegions {
+		if newcmk, ok := newRegions[region]; ok && oldcmk != newcmk {
+			return fmt.Errorf("Incident Manager does not support updating encryption on a Replication Set's region (%s). To do this, remove the region, and then re-create it with the new key", region)
+		}
+	}
+
+	return nil
+}
+
 func resourceReplicationSetImport(context context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*conns.AWSClient).SSMIncidentsClient()
 
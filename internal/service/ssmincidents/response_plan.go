@@ -3,11 +3,14 @@ package ssmincidents
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -95,8 +98,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"chat_channel": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			"display_name": {
 				Type:     schema.TypeString,
@@ -105,8 +111,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"engagements": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validContactARN,
+				},
+				Set: schema.HashString,
 			},
 			"incident_template": {
 				Type:     schema.TypeList,
@@ -318,3 +327,21 @@ func updateResponsePlanInputWithIncidentTemplate(input *ssmincidents.UpdateRespo
 	input.IncidentTemplateDedupeString = template.DedupeString
 	input.IncidentTemplateSummary = template.Summary
 }
+
+// validContactARN validates that an engagement is the ARN of an Incident Manager contact or escalation plan.
+func validContactARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "ssm-contacts" || !strings.HasPrefix(parsedARN.Resource, "contact/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of an Incident Manager contact or escalation plan", k, value))
+	}
+
+	return
+}
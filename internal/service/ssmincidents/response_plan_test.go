@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -646,6 +647,39 @@ func testResponsePlan_engagement(t *testing.T) {
 	})
 }
 
+func testResponsePlan_engagementInvalid(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := context.Background()
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	//lintignore:AWSAT003
+	//lintignore:AWSAT005
+	topicArn := "arn:aws:sns:us-east-2:111122223333:test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResponsePlanConfig_engagement(rName, topicArn),
+				ExpectError: regexp.MustCompile(`must be the ARN of an Incident Manager contact or escalation plan`),
+			},
+			{
+				Config:      testAccResponsePlanConfig_engagement(rName, "test1"),
+				ExpectError: regexp.MustCompile(`is an invalid ARN`),
+			},
+		},
+	})
+}
+
 func testResponsePlan_action(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
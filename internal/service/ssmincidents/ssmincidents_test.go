@@ -32,6 +32,7 @@ func TestAccSSMIncidents_serial(t *testing.T) {
 			"displayName":            testResponsePlan_displayName,
 			"chatChannel":            testResponsePlan_chatChannel,
 			"engagement":             testResponsePlan_engagement,
+			"engagementInvalid":      testResponsePlan_engagementInvalid,
 			"action":                 testResponsePlan_action,
 		},
 		"Response Plan Data Source Tests": {
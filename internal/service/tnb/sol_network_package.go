@@ -0,0 +1,285 @@
+package tnb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_tnb_sol_network_package", name="Sol Network Package")
+// @Tags(identifierAttribute="arn")
+func ResourceSolNetworkPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSolNetworkPackageCreate,
+		ReadWithoutTimeout:   resourceSolNetworkPackageRead,
+		UpdateWithoutTimeout: resourceSolNetworkPackageUpdate,
+		DeleteWithoutTimeout: resourceSolNetworkPackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filename": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"onboarding_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tnb.NsdOperationalState_Values(), false),
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"usage_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_package_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSolNetworkPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	filename := d.Get("filename").(string)
+	file, err := os.ReadFile(filename)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Sol Network Package file (%s): %s", filename, err)
+	}
+
+	output, err := conn.CreateSolNetworkPackageWithContext(ctx, &tnb.CreateSolNetworkPackageInput{
+		Tags: GetTagsIn(ctx),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating TNB Sol Network Package: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	_, err = conn.PutSolNetworkPackageContentWithContext(ctx, &tnb.PutSolNetworkPackageContentInput{
+		ContentType: aws.String(tnb.PackageContentTypeApplicationZip),
+		File:        file,
+		NsdInfoId:   aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting TNB Sol Network Package (%s) content: %s", d.Id(), err)
+	}
+
+	if _, err := waitSolNetworkPackageOnboarded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for TNB Sol Network Package (%s) onboard: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("operational_state"); ok && v.(string) != tnb.NsdOperationalStateEnabled {
+		if err := updateSolNetworkPackageOperationalState(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSolNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceSolNetworkPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	output, err := FindSolNetworkPackageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] TNB Sol Network Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Sol Network Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("nsd_id", output.NsdId)
+	d.Set("nsd_name", output.NsdName)
+	d.Set("nsd_version", output.NsdVersion)
+	d.Set("onboarding_state", output.NsdOnboardingState)
+	d.Set("operational_state", output.NsdOperationalState)
+	d.Set("usage_state", output.NsdUsageState)
+	d.Set("vnf_package_ids", aws.StringValueSlice(output.VnfPkgIds))
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceSolNetworkPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	if d.HasChange("operational_state") {
+		if err := updateSolNetworkPackageOperationalState(ctx, conn, d.Id(), d.Get("operational_state").(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceSolNetworkPackageRead(ctx, d, meta)...)
+}
+
+func resourceSolNetworkPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+
+	// A network package must be disabled before it can be deleted.
+	if d.Get("operational_state").(string) == tnb.NsdOperationalStateEnabled {
+		err := updateSolNetworkPackageOperationalState(ctx, conn, d.Id(), tnb.NsdOperationalStateDisabled)
+
+		if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting TNB Sol Network Package: %s", d.Id())
+	_, err := conn.DeleteSolNetworkPackageWithContext(ctx, &tnb.DeleteSolNetworkPackageInput{
+		NsdInfoId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting TNB Sol Network Package (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateSolNetworkPackageOperationalState(ctx context.Context, conn *tnb.Tnb, id, state string) error {
+	_, err := conn.UpdateSolNetworkPackageWithContext(ctx, &tnb.UpdateSolNetworkPackageInput{
+		NsdInfoId:           aws.String(id),
+		NsdOperationalState: aws.String(state),
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating TNB Sol Network Package (%s) operational state (%s): %w", id, state, err)
+	}
+
+	return nil
+}
+
+func FindSolNetworkPackageByID(ctx context.Context, conn *tnb.Tnb, id string) (*tnb.GetSolNetworkPackageOutput, error) {
+	input := &tnb.GetSolNetworkPackageInput{
+		NsdInfoId: aws.String(id),
+	}
+
+	output, err := conn.GetSolNetworkPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, tnb.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusSolNetworkPackageOnboardingState(ctx context.Context, conn *tnb.Tnb, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSolNetworkPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.NsdOnboardingState), nil
+	}
+}
+
+func waitSolNetworkPackageOnboarded(ctx context.Context, conn *tnb.Tnb, id string, timeout time.Duration) (*tnb.GetSolNetworkPackageOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tnb.NsdOnboardingStateCreated},
+		Target:  []string{tnb.NsdOnboardingStateOnboarded},
+		Refresh: statusSolNetworkPackageOnboardingState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*tnb.GetSolNetworkPackageOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,90 @@
+package tnb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_tnb_sol_network_package", name="Sol Network Package")
+func DataSourceSolNetworkPackage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSolNetworkPackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nsd_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsd_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"onboarding_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"usage_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnf_package_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSolNetworkPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TNBConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id := d.Get("id").(string)
+	output, err := FindSolNetworkPackageByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading TNB Sol Network Package (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+	d.Set("arn", output.Arn)
+	d.Set("nsd_id", output.NsdId)
+	d.Set("nsd_name", output.NsdName)
+	d.Set("nsd_version", output.NsdVersion)
+	d.Set("onboarding_state", output.NsdOnboardingState)
+	d.Set("operational_state", output.NsdOperationalState)
+	d.Set("usage_state", output.NsdUsageState)
+	d.Set("vnf_package_ids", aws.StringValueSlice(output.VnfPkgIds))
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,48 @@
+package tnb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestAccTNBSolNetworkPackageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	filename := envvar.SkipIfEmpty(t, envVarNetworkPackageFile, envVarNetworkPackageFileMessageError)
+	dataSourceName := "data.aws_tnb_sol_network_package.test"
+	resourceName := "aws_tnb_sol_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolNetworkPackageDataSourceConfig_basic(filename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nsd_id", resourceName, "nsd_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nsd_name", resourceName, "nsd_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nsd_version", resourceName, "nsd_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "onboarding_state", resourceName, "onboarding_state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "operational_state", resourceName, "operational_state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_state", resourceName, "usage_state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vnf_package_ids.#", resourceName, "vnf_package_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSolNetworkPackageDataSourceConfig_basic(filename string) string {
+	return acctest.ConfigCompose(testAccSolNetworkPackageConfig_tags1(filename, "key1", "value1"), `
+data "aws_tnb_sol_network_package" "test" {
+  id = aws_tnb_sol_network_package.test.id
+}
+`)
+}
@@ -0,0 +1,252 @@
+package tnb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/tnb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tftnb "github.com/hashicorp/terraform-provider-aws/internal/service/tnb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Building a network service descriptor and onboarding its function packages is outside the scope of these tests.
+const (
+	envVarNetworkPackageFile             = "TNB_NETWORK_PACKAGE_FILE"
+	envVarNetworkPackageFileMessageError = "Environment variable TNB_NETWORK_PACKAGE_FILE is not set. " +
+		"It must be the path to a network package .zip file whose function packages are already onboarded."
+)
+
+func TestAccTNBSolNetworkPackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	filename := envvar.SkipIfEmpty(t, envVarNetworkPackageFile, envVarNetworkPackageFileMessageError)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_sol_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolNetworkPackageConfig_basic(filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "nsd_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "nsd_name"),
+					resource.TestCheckResourceAttr(resourceName, "onboarding_state", tnb.NsdOnboardingStateOnboarded),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", tnb.NsdOperationalStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "usage_state", tnb.NsdUsageStateNotInUse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename"},
+			},
+		},
+	})
+}
+
+func TestAccTNBSolNetworkPackage_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	filename := envvar.SkipIfEmpty(t, envVarNetworkPackageFile, envVarNetworkPackageFileMessageError)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_sol_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolNetworkPackageConfig_operationalState(filename, tnb.NsdOperationalStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftnb.ResourceSolNetworkPackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTNBSolNetworkPackage_operationalState(t *testing.T) {
+	ctx := acctest.Context(t)
+	filename := envvar.SkipIfEmpty(t, envVarNetworkPackageFile, envVarNetworkPackageFileMessageError)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_sol_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolNetworkPackageConfig_operationalState(filename, tnb.NsdOperationalStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", tnb.NsdOperationalStateDisabled),
+				),
+			},
+			{
+				Config: testAccSolNetworkPackageConfig_operationalState(filename, tnb.NsdOperationalStateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "operational_state", tnb.NsdOperationalStateEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTNBSolNetworkPackage_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	filename := envvar.SkipIfEmpty(t, envVarNetworkPackageFile, envVarNetworkPackageFileMessageError)
+	var v tnb.GetSolNetworkPackageOutput
+	resourceName := "aws_tnb_sol_network_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tnb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolNetworkPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolNetworkPackageConfig_tags1(filename, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename"},
+			},
+			{
+				Config: testAccSolNetworkPackageConfig_tags2(filename, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSolNetworkPackageConfig_tags1(filename, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolNetworkPackageExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSolNetworkPackageExists(ctx context.Context, n string, v *tnb.GetSolNetworkPackageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TNB Sol Network Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		output, err := tftnb.FindSolNetworkPackageByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSolNetworkPackageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TNBConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tnb_sol_network_package" {
+				continue
+			}
+
+			_, err := tftnb.FindSolNetworkPackageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("TNB Sol Network Package %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSolNetworkPackageConfig_basic(filename string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_sol_network_package" "test" {
+  filename = %[1]q
+}
+`, filename)
+}
+
+func testAccSolNetworkPackageConfig_operationalState(filename, operationalState string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_sol_network_package" "test" {
+  filename          = %[1]q
+  operational_state = %[2]q
+}
+`, filename, operationalState)
+}
+
+func testAccSolNetworkPackageConfig_tags1(filename, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_sol_network_package" "test" {
+  filename = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, filename, tagKey1, tagValue1)
+}
+
+func testAccSolNetworkPackageConfig_tags2(filename, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_tnb_sol_network_package" "test" {
+  filename = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, filename, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -128,6 +128,57 @@ func TestAccTranscribeVocabularyFilter_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "words.#", "3"),
 				),
 			},
+			{
+				Config: testAccVocabularyFilterConfig_basicFile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(ctx, resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_filter_file_uri", "s3://"+rName+"/transcribe/test1.txt"),
+					resource.TestCheckResourceAttr(resourceName, "words.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabularyFilter_updateFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var vocabularyFilter transcribe.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	file1 := "test1.txt"
+	file2 := "test2.txt"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccVocabularyFiltersPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVocabularyFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfig_updateFile(rName, file1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(ctx, resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_filter_file_uri", "s3://"+rName+"/transcribe/test1.txt"),
+				),
+			},
+			{
+				Config: testAccVocabularyFilterConfig_updateFile(rName, file2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(ctx, resourceName, &vocabularyFilter),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_filter_file_uri", "s3://"+rName+"/transcribe/test2.txt"),
+				),
+			},
 		},
 	})
 }
@@ -291,6 +342,12 @@ resource "aws_s3_object" "object1" {
   key    = "transcribe/test1.txt"
   source = "test-fixtures/vocabulary_filter_test1.txt"
 }
+
+resource "aws_s3_object" "object2" {
+  bucket = aws_s3_bucket.test.id
+  key    = "transcribe/test2.txt"
+  source = "test-fixtures/vocabulary_filter_test2.txt"
+}
 `, rName)
 }
 
@@ -332,6 +389,28 @@ resource "aws_transcribe_vocabulary_filter" "test" {
 `, rName))
 }
 
+func testAccVocabularyFilterConfig_updateFile(rName, fileName string) string {
+	return acctest.ConfigCompose(
+		testAccVocabularyFilterBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name     = %[1]q
+  language_code              = "en-US"
+  vocabulary_filter_file_uri = "s3://${aws_s3_bucket.test.id}/transcribe/%[2]s"
+
+  tags = {
+    tag1 = "value1"
+    tag2 = "value3"
+  }
+
+  depends_on = [
+    aws_s3_object.object1,
+    aws_s3_object.object2
+  ]
+}
+`, rName, fileName))
+}
+
 func testAccVocabularyFilterConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccVocabularyFilterBaseConfig(rName),
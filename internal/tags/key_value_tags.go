@@ -27,9 +27,32 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// unknownValue is the value that the Terraform Plugin SDK uses for unknown values in provider configuration,
+// e.g. provider-level default tag values derived from attributes of resources that are not yet created.
+// Reference: terraform-plugin-sdk/v2/internal/configs/hcl2shim.UnknownVariableValue.
+const unknownValue = `74D93920-ED26-11E3-AC10-0800200C9A66`
+
+const (
+	// DefaultTagsPrecedenceResource gives resource-level tag values precedence over provider default_tags.
+	DefaultTagsPrecedenceResource = "resource"
+	// DefaultTagsPrecedenceProvider gives provider default_tags values precedence over resource-level tags.
+	DefaultTagsPrecedenceProvider = "provider"
+)
+
+// DefaultTagsPrecedence_Values returns all valid values for default tags precedence.
+func DefaultTagsPrecedence_Values() []string {
+	return []string{
+		DefaultTagsPrecedenceResource,
+		DefaultTagsPrecedenceProvider,
+	}
+}
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Precedence determines whether resource-level tags or default tags win
+	// when the same key is configured in both. Defaults to resource-level tags.
+	Precedence string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -53,14 +76,30 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ProviderPrecedence returns true if the DefaultConfig's Tags take precedence
+// over resource-level tags with a matching key.
+func (dc *DefaultConfig) ProviderPrecedence() bool {
+	if dc == nil {
+		return false
+	}
+
+	return dc.Precedence == DefaultTagsPrecedenceProvider
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// If the DefaultConfig is configured with provider precedence,
+// the DefaultConfig.Tags instead override the given KeyValueTags.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
+	if dc.ProviderPrecedence() {
+		return make(KeyValueTags).Merge(tags).Merge(dc.Tags)
+	}
+
 	return dc.Tags.Merge(tags)
 }
 
@@ -446,6 +485,17 @@ func (tags KeyValueTags) HasZeroValue() bool {
 	return false
 }
 
+// HasUnknownValue returns whether or not any tag values are unknown.
+func (tags KeyValueTags) HasUnknownValue() bool {
+	for _, v := range tags {
+		if v.ValueString() == unknownValue {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Equal returns whether or two sets of key-value tags are equal.
 func (tags KeyValueTags) Equal(other KeyValueTags) bool {
 	if tags == nil && other == nil {
@@ -500,6 +550,9 @@ func (tags KeyValueTags) Hash() int {
 // however, if all tags present in the DefaultConfig object are equivalent to those
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
+// If the DefaultConfig is configured with provider precedence, all tags with a key
+// present in the DefaultConfig object are removed as their values cannot originate
+// from resource-level tags.
 func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
@@ -508,7 +561,14 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		defaultVal, ok := dc.Tags[k]
+
+		if !ok {
+			result[k] = v
+			continue
+		}
+
+		if !dc.ProviderPrecedence() && !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
@@ -800,7 +860,8 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v {
+				// With provider precedence the configured value is overridden, so keep it as configured.
+				if val, ok := defaultConfig.Tags[k]; ok && (val.ValueString() == v || defaultConfig.ProviderPrecedence()) {
 					result[k] = v
 				}
 			}
@@ -838,7 +899,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultConfig.Tags[k]; ok && (val.ValueString() == s || defaultConfig.ProviderPrecedence()) {
 					result[k] = s
 				}
 			}
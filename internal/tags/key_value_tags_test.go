@@ -164,6 +164,25 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "keys some overridden provider precedence",
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				Precedence: DefaultTagsPrecedenceProvider,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
 		{
 			name: "keys none matching",
 			tags: New(ctx, map[string]string{
@@ -1965,6 +1984,59 @@ func TestKeyValueTagsContainsAll(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsHasUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want bool
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			want: false,
+		},
+		{
+			name: "known",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "",
+			}),
+			want: false,
+		},
+		{
+			name: "unknown",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": unknownValue,
+			}),
+			want: true,
+		},
+		{
+			name: "nil value",
+			tags: New(ctx, map[string]*string{
+				"key1": nil,
+			}),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.HasUnknownValue()
+
+			if got != testCase.want {
+				t.Errorf("unexpected HasUnknownValue: %t", got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -2246,6 +2318,24 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "keys some overridden provider precedence",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				Precedence: DefaultTagsPrecedenceProvider,
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
 		{
 			name: "keys none matching",
 			tags: New(ctx, map[string]string{
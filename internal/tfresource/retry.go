@@ -74,6 +74,22 @@ func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration,
 	})
 }
 
+// RetryWhenAWSErrMessageContainsAny retries the specified function when it returns an AWS error with the specified code
+// and a message containing any of the specified messages.
+// It is typically used to wait out IAM eventual consistency, where a newly created or updated role or policy is not yet
+// visible to the calling service and the service reports one of several validation errors.
+func RetryWhenAWSErrMessageContainsAny(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code string, messages ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		for _, message := range messages {
+			if tfawserr.ErrMessageContains(err, code, message) {
+				return true, err
+			}
+		}
+
+		return false, err
+	})
+}
+
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if errs.IsA[T](err) {
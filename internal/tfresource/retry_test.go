@@ -147,6 +147,84 @@ func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:ci.aws-in-f
 	}
 }
 
+//nolint:tparallel
+func TestRetryWhenAWSErrMessageContainsAny(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error code",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode2", "TestMessage1", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage2", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("TestCode1", "TestMessage1", nil)
+				}
+
+				if atomic.CompareAndSwapInt32(&retryCount, 1, 2) {
+					return nil, awserr.New("TestCode1", "TestMessage2", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenAWSErrMessageContainsAny(ctx, 5*time.Second, testCase.F, "TestCode1", "TestMessage1", "TestMessage2")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestRetryWhenNewResourceNotFound(t *testing.T) { //nolint:tparallel
 	ctx := acctest.Context(t)
 	t.Parallel()
@@ -41,6 +41,15 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Provider-level default tag values that are unknown, e.g. derived from resources not yet created,
+	// only make "tags_all" unknown if they are not overridden by resource-level tags or ignored.
+	if allTags.HasUnknownValue() {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+		return nil
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -215,6 +215,7 @@ const (
 	Nimble                       = "nimble"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
+	OpenSearchIngestion          = "osis"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
 	OpsWorksCM                   = "opsworkscm"
@@ -292,6 +293,7 @@ const (
 	Shield                       = "shield"
 	Signer                       = "signer"
 	SimpleDB                     = "simpledb"
+	SimSpaceWeaver               = "simspaceweaver"
 	SnowDeviceManagement         = "snowdevicemanagement"
 	Snowball                     = "snowball"
 	StorageGateway               = "storagegateway"
@@ -300,6 +302,7 @@ const (
 	Textract                     = "textract"
 	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"
+	TNB                          = "tnb"
 	Transcribe                   = "transcribe"
 	TranscribeStreaming          = "transcribestreaming"
 	Transfer                     = "transfer"